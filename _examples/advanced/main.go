@@ -11,105 +11,32 @@ import (
 )
 
 // Example 1: Thai ID Card Validator
-// Example 1: Thai ID Card Validator
-// Thai National ID Card uses MOD 11 checksum algorithm
-// Structure: 12 digits + 1 checksum digit (total 13 digits)
-// Checksum calculation:
-//  1. Multiply each of the first 12 digits by (13 - position)
-//  2. Sum all products
-//  3. Calculate: (11 - (sum % 11)) % 11
-//  4. If result is 10, use 0 as checksum
-//  5. Last digit must equal the calculated checksum
-func validateThaiIDCard(fl validator.FieldLevel) bool {
-	idCard := fl.Field().String()
-
-	// Must be exactly 13 digits
-	if len(idCard) != 13 {
-		return false
-	}
-
-	// All characters must be numeric
-	for _, char := range idCard {
-		if char < '0' || char > '9' {
-			return false
-		}
-	}
-
-	// Calculate checksum using MOD 11 algorithm
-	sum := 0
-	for i := 0; i < 12; i++ {
-		digit := int(idCard[i] - '0')
-		sum += digit * (13 - i) // Weight: 13, 12, 11, ..., 2
-	}
-
-	checksum := (11 - (sum % 11)) % 11
-	if checksum == 10 {
-		checksum = 0 // Replace 10 with 0
-	}
-
-	lastDigit := int(idCard[12] - '0')
-	return checksum == lastDigit
-}
-
+// Thai national ID validation is built in as the thai_id tag (13 digits with MOD 11 checksum),
+// so no custom validator needs to be registered.
 type ThaiCitizen struct {
 	Name   string `validate:"required,min=2,max=100"`
-	IDCard string `validate:"required,thai_id_card"`
+	IDCard string `validate:"required,thai_id"`
 }
 
 // Example 2: Business Hours Validator
-func validateBusinessHours(fl validator.FieldLevel) bool {
-	timeStr := fl.Field().String()
-	t, err := time.Parse("15:04", timeStr)
-	if err != nil {
-		return false
-	}
-	start, _ := time.Parse("15:04", "09:00")
-	end, _ := time.Parse("15:04", "17:00")
-	return (t.Equal(start) || t.After(start)) && (t.Before(end) || t.Equal(end))
-}
-
+// Time-of-day windows are built in as the time_between tag (HH:MM strings, bounds included),
+// so no custom validator needs to be registered.
 type Appointment struct {
 	CustomerName string `validate:"required"`
-	Time         string `validate:"required,business_hours"`
+	Time         string `validate:"required,time_between=09:00:17:00"`
 }
 
 // Example 3: Thai Phone Number Validator
-func validateThaiPhone(fl validator.FieldLevel) bool {
-	phone := fl.Field().String()
-	if len(phone) != 10 || phone[0] != '0' {
-		return false
-	}
-	for _, char := range phone {
-		if char < '0' || char > '9' {
-			return false
-		}
-	}
-	prefix := phone[0:2]
-	validPrefixes := []string{"02", "06", "08", "09"}
-	for _, valid := range validPrefixes {
-		if prefix == valid {
-			return true
-		}
-	}
-	return false
-}
-
+// Thai local-format phone validation is built in as the thai_phone tag (libphonenumber, region TH),
+// so no custom validator needs to be registered.
 type Contact struct {
 	Name      string `validate:"required"`
 	ThaiPhone string `validate:"required,thai_phone"`
 }
 
 // Example 4: Future Date Validator
-func validateFutureDate(fl validator.FieldLevel) bool {
-	dateStr := fl.Field().String()
-	date, err := time.Parse("2006-01-02", dateStr)
-	if err != nil {
-		return false
-	}
-	now := time.Now()
-	return date.After(now)
-}
-
+// Future date validation is built in as the future_date tag (layout "2006-01-02" by default),
+// so no custom validator needs to be registered.
 type Event struct {
 	Name      string `validate:"required"`
 	StartDate string `validate:"required,future_date"`
@@ -146,15 +73,11 @@ func main() {
 	}
 
 	// Register all custom validators
-	v.GetValidator().RegisterValidation("thai_id_card", validateThaiIDCard)
-	v.GetValidator().RegisterValidation("business_hours", validateBusinessHours)
-	v.GetValidator().RegisterValidation("thai_phone", validateThaiPhone)
-	v.GetValidator().RegisterValidation("future_date", validateFutureDate)
 	v.GetValidator().RegisterValidation("product_price", validateDecimalRange(1.00, 1000000.00))
 
 	// Example 1: Thai ID Card
 	fmt.Println("═══════════════════════════════════════════════════")
-	fmt.Println("Example 1: Custom Validator - Thai ID Card")
+	fmt.Println("Example 1: Built-in Validator - Thai ID Card (thai_id)")
 	fmt.Println("═══════════════════════════════════════════════════")
 	fmt.Println("\nℹ️  Thai ID Card Structure: 12 digits + 1 checksum digit (MOD 11)")
 	fmt.Println("   The last digit is calculated from the first 12 digits")
@@ -188,7 +111,7 @@ func main() {
 
 	// Example 2: Business Hours
 	fmt.Println("\n═══════════════════════════════════════════════════")
-	fmt.Println("Example 2: Custom Validator - Business Hours")
+	fmt.Println("Example 2: Built-in Validator - Business Hours (time_between)")
 	fmt.Println("═══════════════════════════════════════════════════")
 	valid2 := Appointment{
 		CustomerName: "John Doe",
@@ -216,7 +139,7 @@ func main() {
 
 	// Example 3: Thai Phone Number
 	fmt.Println("\n═══════════════════════════════════════════════════")
-	fmt.Println("Example 3: Custom Validator - Thai Phone Number")
+	fmt.Println("Example 3: Built-in Validator - Thai Phone Number (thai_phone)")
 	fmt.Println("═══════════════════════════════════════════════════")
 	valid3 := Contact{
 		Name:      "สมชาย รักษ์ดี",
@@ -244,7 +167,7 @@ func main() {
 
 	// Example 4: Future Date
 	fmt.Println("\n═══════════════════════════════════════════════════")
-	fmt.Println("Example 4: Custom Validator - Future Date")
+	fmt.Println("Example 4: Built-in Validator - Future Date (future_date)")
 	fmt.Println("═══════════════════════════════════════════════════")
 	tomorrow := time.Now().AddDate(0, 0, 1).Format("2006-01-02")
 	valid4 := Event{
@@ -12,7 +12,7 @@ type Address struct {
 	City       string `json:"city" validate:"required,min=2,max=100"`
 	State      string `json:"state" validate:"required,len=2"`
 	PostalCode string `json:"postal_code" validate:"required,len=5"`
-	Country    string `json:"country" validate:"required,len=2"`
+	Country    string `json:"country" validate:"required,country_code"`
 }
 
 // Person demonstrates nested struct with pointer
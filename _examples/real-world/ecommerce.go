@@ -12,7 +12,7 @@ type Address struct {
 	City       string `json:"city" validate:"required,min=2,max=100"`
 	State      string `json:"state" validate:"required,len=2"`
 	PostalCode string `json:"postal_code" validate:"required,len=5"`
-	Country    string `json:"country" validate:"required,len=2"`
+	Country    string `json:"country" validate:"required,country_code"`
 	Phone      string `json:"phone" validate:"required,mobile_e164"`
 }
 
@@ -37,7 +37,7 @@ type EcommerceOrder struct {
 	ShippingFee     string     `json:"shipping_fee" validate:"required,decimal=10:2,dgte=0"`
 	Tax             string     `json:"tax" validate:"required,decimal=10:2,dgte=0"`
 	Discount        string     `json:"discount" validate:"required,decimal=10:2,dgte=0"`
-	Total           string     `json:"total" validate:"required,decimal=10:2,dgt=0"`
+	Total           string     `json:"total" validate:"required,decimal=10:2,dgt=0,dsum=Subtotal+ShippingFee+Tax-Discount"`
 	PaymentMethod   string     `json:"payment_method" validate:"required,oneof=credit_card debit_card bank_transfer ewallet cod"`
 	Notes           string     `json:"notes" validate:"omitempty,max=500"`
 }
@@ -15,7 +15,7 @@ type PaymentRequest struct {
 
 	// Amount Details
 	Amount   string `json:"amount" validate:"required,decimal=10:2,dgt=0,dlte=1000000"`
-	Currency string `json:"currency" validate:"required,len=3"`
+	Currency string `json:"currency" validate:"required,iso4217"`
 	Tax      string `json:"tax" validate:"required,decimal=10:2,dgte=0"`
 	Fee      string `json:"fee" validate:"required,decimal=10:2,dgte=0"`
 	Total    string `json:"total" validate:"required,decimal=10:2,dgt=0"`
@@ -37,10 +37,10 @@ type PaymentRequest struct {
 // CardDetails for credit/debit card payments
 type CardDetails struct {
 	CardholderName string `json:"cardholder_name" validate:"required,min=3,max=100"`
-	CardNumber     string `json:"card_number" validate:"required,len=16"`
+	CardNumber     string `json:"card_number" validate:"required,card_number=visa mastercard"`
 	ExpiryMonth    string `json:"expiry_month" validate:"required,len=2"`
-	ExpiryYear     string `json:"expiry_year" validate:"required,len=2"`
-	CVV            string `json:"cvv" validate:"required,len=3"`
+	ExpiryYear     string `json:"expiry_year" validate:"required,len=2,card_expiry=ExpiryMonth:ExpiryYear"`
+	CVV            string `json:"cvv" validate:"required,cvv=CardNumber"`
 	BillingZip     string `json:"billing_zip" validate:"required,len=5"`
 }
 
@@ -66,9 +66,9 @@ func main() {
 		PaymentMethod: "credit_card",
 		CardDetails: &CardDetails{
 			CardholderName: "John Doe",
-			CardNumber:     "4532123456789012",
+			CardNumber:     "4111111111111111",
 			ExpiryMonth:    "12",
-			ExpiryYear:     "25",
+			ExpiryYear:     "29",
 			CVV:            "123",
 			BillingZip:     "10110",
 		},
@@ -131,9 +131,9 @@ func main() {
 		PaymentMethod: "credit_card",
 		CardDetails: &CardDetails{
 			CardholderName: "Jane Smith",
-			CardNumber:     "4532987654321098",
+			CardNumber:     "5555555555554444",
 			ExpiryMonth:    "06",
-			ExpiryYear:     "26",
+			ExpiryYear:     "29",
 			CVV:            "456",
 			BillingZip:     "10120",
 		},
@@ -163,9 +163,9 @@ func main() {
 		PaymentMethod: "credit_card",
 		CardDetails: &CardDetails{
 			CardholderName: "Bob Johnson",
-			CardNumber:     "4532111122223333",
+			CardNumber:     "4012888888881881",
 			ExpiryMonth:    "03",
-			ExpiryYear:     "27",
+			ExpiryYear:     "30",
 			CVV:            "789",
 			BillingZip:     "10130",
 		},
@@ -206,8 +206,8 @@ func main() {
 	}
 	fmt.Println()
 
-	// Example 6: Invalid - invalid card details
-	fmt.Println("Example 6: Invalid - Invalid Card Number Length")
+	// Example 6: Invalid - invalid card number and expired card
+	fmt.Println("Example 6: Invalid - Invalid Card Number and Expired Card")
 	invalidPayment4 := PaymentRequest{
 		TransactionID: "TXN-2024-006",
 		OrderID:       "ORD-2024-006",
@@ -220,9 +220,9 @@ func main() {
 		PaymentMethod: "credit_card",
 		CardDetails: &CardDetails{
 			CardholderName: "Alice Brown",
-			CardNumber:     "45321234", // Too short (must be 16 digits)
+			CardNumber:     "45321234", // Too short and fails the Luhn checksum
 			ExpiryMonth:    "08",
-			ExpiryYear:     "25",
+			ExpiryYear:     "25", // Expired
 			CVV:            "123",
 			BillingZip:     "10140",
 		},
@@ -30,7 +30,7 @@ type UserRegistration struct {
 	City       string `json:"city" validate:"required,min=2,max=100"`
 	State      string `json:"state" validate:"required,len=2"`
 	PostalCode string `json:"postal_code" validate:"required,len=5"`
-	Country    string `json:"country" validate:"required,len=2"`
+	Country    string `json:"country" validate:"required,country_code"`
 
 	// Terms
 	AcceptTerms     bool `json:"accept_terms" validate:"required,eq=true"`
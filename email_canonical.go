@@ -0,0 +1,64 @@
+package xvalidator
+
+import "strings"
+
+// emailProvider describes how a mail provider treats local parts, for CanonicalEmail.
+type emailProvider struct {
+	domain     string // canonical domain of the provider
+	ignoreDots bool   // dots in the local part are not significant
+}
+
+// emailProviders maps provider domains to their addressing rules. Every listed provider ignores the
+// case of the local part and delivers "user+tag" to "user".
+var emailProviders = map[string]emailProvider{
+	"gmail.com":      {domain: "gmail.com", ignoreDots: true},
+	"googlemail.com": {domain: "gmail.com", ignoreDots: true},
+	"outlook.com":    {domain: "outlook.com"},
+	"hotmail.com":    {domain: "hotmail.com"},
+	"live.com":       {domain: "live.com"},
+	"icloud.com":     {domain: "icloud.com"},
+	"me.com":         {domain: "me.com"},
+	"mac.com":        {domain: "mac.com"},
+	"fastmail.com":   {domain: "fastmail.com"},
+	"proton.me":      {domain: "proton.me"},
+	"protonmail.com": {domain: "protonmail.com"},
+	"pm.me":          {domain: "pm.me"},
+}
+
+// NormalizeEmail trims surrounding whitespace from an email address and lowercases its domain.
+// The local part is kept as is, since it may be case-sensitive. Values without "@" are only trimmed.
+func NormalizeEmail(email string) string {
+	email = strings.TrimSpace(email)
+	i := strings.LastIndexByte(email, '@')
+	if i < 0 {
+		return email
+	}
+	return email[:i+1] + strings.ToLower(strings.TrimSuffix(email[i+1:], "."))
+}
+
+// CanonicalEmail normalizes an email address like NormalizeEmail and applies the addressing rules of
+// well-known providers, so addresses delivered to the same mailbox compare equal: for Gmail the local part
+// is lowercased, dots and "+tag" suffixes are removed and googlemail.com becomes gmail.com; Outlook,
+// iCloud, Fastmail and Proton addresses are lowercased and lose their "+tag" suffix.
+// Use it when checking email uniqueness, and store the address as entered for sending mail.
+func CanonicalEmail(email string) string {
+	email = NormalizeEmail(email)
+	i := strings.LastIndexByte(email, '@')
+	if i <= 0 {
+		return email
+	}
+
+	provider, ok := emailProviders[email[i+1:]]
+	if !ok {
+		return email
+	}
+
+	local := strings.ToLower(email[:i])
+	if tag := strings.IndexByte(local, '+'); tag > 0 {
+		local = local[:tag]
+	}
+	if provider.ignoreDots {
+		local = strings.ReplaceAll(local, ".", "")
+	}
+	return local + "@" + provider.domain
+}
@@ -0,0 +1,64 @@
+package xvalidator
+
+import (
+	_ "embed"
+	"strings"
+	"sync"
+)
+
+// disposableEmailDomainsData is the embedded list of disposable email domains, one per line.
+// Lines starting with "#" are comments.
+//
+//go:embed disposable_email_domains.txt
+var disposableEmailDomainsData string
+
+// domainSet is a set of lower-cased domain names.
+type domainSet map[string]struct{}
+
+// newDomainSet builds a domainSet from domains, ignoring empty entries and trailing dots.
+func newDomainSet(domains []string) domainSet {
+	set := make(domainSet, len(domains))
+	for _, domain := range domains {
+		if domain = strings.TrimSuffix(strings.ToLower(strings.TrimSpace(domain)), "."); domain != "" {
+			set[domain] = struct{}{}
+		}
+	}
+	return set
+}
+
+// embeddedDisposableEmailDomains parses the embedded list once, on first use.
+var embeddedDisposableEmailDomains = sync.OnceValue(func() domainSet {
+	return newDomainSet(DisposableEmailDomains())
+})
+
+// DisposableEmailDomains returns the embedded list of disposable email domains.
+// Use it with WithDisposableEmailDomains to extend the list with newly seen providers.
+func DisposableEmailDomains() []string {
+	return parseEmbeddedList(disposableEmailDomainsData)
+}
+
+// parseEmbeddedList returns the entries of an embedded list, skipping blank lines and "#" comments.
+func parseEmbeddedList(data string) []string {
+	var domains []string
+	for _, line := range strings.Split(data, "\n") {
+		if line = strings.TrimSpace(line); line != "" && !strings.HasPrefix(line, "#") {
+			domains = append(domains, line)
+		}
+	}
+	return domains
+}
+
+// contains reports whether domain or one of its parent domains is in the set,
+// so "inbox.mailinator.com" matches "mailinator.com".
+func (s domainSet) contains(domain string) bool {
+	for {
+		if _, ok := s[domain]; ok {
+			return true
+		}
+		i := strings.IndexByte(domain, '.')
+		if i < 0 {
+			return false
+		}
+		domain = domain[i+1:]
+	}
+}
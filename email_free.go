@@ -0,0 +1,23 @@
+package xvalidator
+
+import (
+	_ "embed"
+	"sync"
+)
+
+// freeEmailDomainsData is the embedded list of free email provider domains, one per line.
+// Lines starting with "#" are comments.
+//
+//go:embed free_email_domains.txt
+var freeEmailDomainsData string
+
+// embeddedFreeEmailDomains parses the embedded list once, on first use.
+var embeddedFreeEmailDomains = sync.OnceValue(func() domainSet {
+	return newDomainSet(FreeEmailDomains())
+})
+
+// FreeEmailDomains returns the embedded list of free (consumer) email provider domains used by email_business.
+// Use it with WithFreeEmailDomains to add regional providers.
+func FreeEmailDomains() []string {
+	return parseEmbeddedList(freeEmailDomainsData)
+}
@@ -0,0 +1,47 @@
+package xvalidator
+
+import (
+	"fmt"
+
+	"github.com/go-playground/validator/v10"
+)
+
+// ConfigError reports a misconfigured validation tag, such as a malformed parameter or a reference
+// to a field that does not exist. It is distinct from validator.ValidationErrors, which describe bad input.
+//
+// Rules raise ConfigError by panicking, following go-playground/validator's handling of invalid tags.
+// The Validator methods recover the panic and return the *ConfigError as the error value. Callers that
+// register the rules on their own *validator.Validate with the Register* functions can do the same with
+// RecoverConfigError.
+type ConfigError struct {
+	Tag    string
+	Param  string
+	Reason string
+}
+
+// Error implements the error interface.
+func (e *ConfigError) Error() string {
+	return fmt.Sprintf("xvalidator: invalid configuration for tag '%s=%s': %s", e.Tag, e.Param, e.Reason)
+}
+
+// panicConfigError aborts validation with a ConfigError describing the current tag.
+func panicConfigError(fl validator.FieldLevel, reason string) {
+	panic(&ConfigError{Tag: fl.GetTag(), Param: fl.Param(), Reason: reason})
+}
+
+// RecoverConfigError converts a ConfigError panic raised by a rule into the returned error.
+// Any other panic is propagated unchanged. It must be deferred directly by the function that validates:
+//
+//	func validate(v *validator.Validate, s any) (err error) {
+//		defer xvalidator.RecoverConfigError(&err)
+//		return v.Struct(s)
+//	}
+func RecoverConfigError(err *error) {
+	if r := recover(); r != nil {
+		configErr, ok := r.(*ConfigError)
+		if !ok {
+			panic(r)
+		}
+		*err = configErr
+	}
+}
@@ -0,0 +1,53 @@
+package xvalidator
+
+import (
+	_ "embed"
+	"strings"
+	"sync"
+)
+
+// localesData is the embedded list of known locale identifiers in language_TERRITORY form, one per line.
+// Lines starting with "#" are comments.
+//
+//go:embed locales.txt
+var localesData string
+
+// embeddedLocales parses the embedded locale list once, on first use.
+var embeddedLocales = sync.OnceValue(func() map[string]struct{} {
+	locales := KnownLocales()
+	set := make(map[string]struct{}, len(locales))
+	for _, locale := range locales {
+		set[locale] = struct{}{}
+	}
+	return set
+})
+
+// KnownLocales returns the embedded list of known locale identifiers, such as "th_TH" and "en_US".
+func KnownLocales() []string {
+	return parseEmbeddedList(localesData)
+}
+
+// splitLocale splits a "ll_CC" or "ll-CC" locale identifier into its language and territory, as written.
+func splitLocale(locale string) (language, territory string, separator byte, ok bool) {
+	i := strings.IndexAny(locale, "_-")
+	if i < 0 {
+		return "", "", 0, false
+	}
+	return locale[:i], locale[i+1:], locale[i], true
+}
+
+// CanonicalLocale returns a known locale identifier in its canonical language_TERRITORY form, whatever its
+// case and separator: "TH-th" and "th-TH" become "th_TH". Unknown locales are returned unchanged, so the
+// locale rule still rejects them.
+func CanonicalLocale(locale string) string {
+	language, territory, _, ok := splitLocale(strings.TrimSpace(locale))
+	if !ok {
+		return locale
+	}
+
+	canonical := strings.ToLower(language) + "_" + strings.ToUpper(territory)
+	if _, known := embeddedLocales()[canonical]; !known {
+		return locale
+	}
+	return canonical
+}
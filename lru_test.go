@@ -0,0 +1,66 @@
+package xvalidator
+
+import (
+	"strconv"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLRUCache(t *testing.T) {
+	t.Run("get and add", func(t *testing.T) {
+		cache := newLRUCache[string, int](2)
+		_, ok := cache.Get("a")
+		assert.False(t, ok)
+
+		cache.Add("a", 1)
+		value, ok := cache.Get("a")
+		assert.True(t, ok)
+		assert.Equal(t, 1, value)
+	})
+
+	t.Run("evicts least recently used", func(t *testing.T) {
+		cache := newLRUCache[string, int](2)
+		cache.Add("a", 1)
+		cache.Add("b", 2)
+		cache.Get("a")
+		cache.Add("c", 3)
+
+		_, ok := cache.Get("b")
+		assert.False(t, ok, "b was least recently used and should be evicted")
+		_, ok = cache.Get("a")
+		assert.True(t, ok)
+		_, ok = cache.Get("c")
+		assert.True(t, ok)
+		assert.Equal(t, 2, cache.Len())
+	})
+
+	t.Run("add replaces existing value", func(t *testing.T) {
+		cache := newLRUCache[string, int](2)
+		cache.Add("a", 1)
+		cache.Add("a", 2)
+
+		value, _ := cache.Get("a")
+		assert.Equal(t, 2, value)
+		assert.Equal(t, 1, cache.Len())
+	})
+
+	t.Run("concurrent access", func(t *testing.T) {
+		cache := newLRUCache[string, int](16)
+		var wg sync.WaitGroup
+		for g := 0; g < 8; g++ {
+			wg.Add(1)
+			go func(g int) {
+				defer wg.Done()
+				for i := 0; i < 100; i++ {
+					key := strconv.Itoa((g + i) % 32)
+					cache.Add(key, i)
+					cache.Get(key)
+				}
+			}(g)
+		}
+		wg.Wait()
+		assert.LessOrEqual(t, cache.Len(), 16)
+	})
+}
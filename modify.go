@@ -0,0 +1,86 @@
+package xvalidator
+
+import (
+	"reflect"
+	"strings"
+)
+
+// ModifierFunc rewrites a string field value for a `mod` struct tag; param is the text after "=", if any.
+type ModifierFunc func(value, param string) string
+
+// defaultModifiers returns the modifiers available to every Validator.
+func defaultModifiers() map[string]ModifierFunc {
+	return map[string]ModifierFunc{
+		"email_normalize":  func(value, _ string) string { return NormalizeEmail(value) },
+		"email_canonical":  func(value, _ string) string { return CanonicalEmail(value) },
+		"locale_canonical": func(value, _ string) string { return CanonicalLocale(value) },
+	}
+}
+
+// RegisterModifier adds a modifier usable in `mod` struct tags, replacing any modifier with the same name.
+// It is not safe to call concurrently with Modify.
+func (v *Validator) RegisterModifier(name string, fn ModifierFunc) {
+	v.modifiers[name] = fn
+}
+
+// Modify rewrites the string fields of the struct s points to according to their `mod` tags, before
+// validation. Modifiers run left to right, for example `mod:"email_canonical"`. Nested structs and
+// pointers to structs are modified too; nil pointers are skipped.
+// An unknown modifier or a value that is not a non-nil pointer to a struct is reported as *ConfigError.
+func (v *Validator) Modify(s any) error {
+	value := reflect.ValueOf(s)
+	if value.Kind() != reflect.Pointer || value.IsNil() || value.Elem().Kind() != reflect.Struct {
+		return &ConfigError{Tag: "mod", Reason: "Modify expects a non-nil pointer to a struct"}
+	}
+	return v.modifyStruct(value.Elem())
+}
+
+// modifyStruct applies the `mod` tags of the fields of the struct value.
+func (v *Validator) modifyStruct(value reflect.Value) error {
+	structType := value.Type()
+	for i := 0; i < structType.NumField(); i++ {
+		structField := structType.Field(i)
+		if !structField.IsExported() {
+			continue
+		}
+
+		field := value.Field(i)
+		if field.Kind() == reflect.Pointer {
+			if field.IsNil() {
+				continue
+			}
+			field = field.Elem()
+		}
+
+		switch field.Kind() {
+		case reflect.Struct:
+			if err := v.modifyStruct(field); err != nil {
+				return err
+			}
+		case reflect.String:
+			tag := structField.Tag.Get("mod")
+			if tag == "" {
+				continue
+			}
+			modified, err := v.applyModifiers(field.String(), tag)
+			if err != nil {
+				return err
+			}
+			field.SetString(modified)
+		}
+	}
+	return nil
+}
+
+// applyModifiers runs the comma-separated modifiers of a `mod` tag over value.
+func (v *Validator) applyModifiers(value, tag string) (string, error) {
+	for _, modifier := range strings.Split(tag, ",") {
+		name, param, _ := strings.Cut(strings.TrimSpace(modifier), "=")
+		fn, ok := v.modifiers[name]
+		if !ok {
+			return "", &ConfigError{Tag: "mod", Param: modifier, Reason: "unknown modifier"}
+		}
+		value = fn(value, param)
+	}
+	return value, nil
+}
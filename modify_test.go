@@ -0,0 +1,73 @@
+package xvalidator
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidator_Modify(t *testing.T) {
+	type Contact struct {
+		Email string `mod:"email_normalize" validate:"required,email"`
+	}
+	type SignUp struct {
+		Email     string   `mod:"email_canonical" validate:"required,email"`
+		Raw       string   `validate:"required"`
+		Backup    *string  `mod:"email_normalize"`
+		Contact   Contact  `validate:"required"`
+		Secondary *Contact `validate:"omitempty"`
+	}
+
+	v, err := NewValidator()
+	require.NoError(t, err)
+
+	t.Run("applies modifiers", func(t *testing.T) {
+		backup := " Backup@Example.COM"
+		signUp := SignUp{
+			Email:   " First.Last+news@GMAIL.com ",
+			Raw:     " Keep ",
+			Backup:  &backup,
+			Contact: Contact{Email: "Jane@Example.COM "},
+		}
+
+		require.NoError(t, v.Modify(&signUp))
+		assert.Equal(t, "firstlast@gmail.com", signUp.Email)
+		assert.Equal(t, " Keep ", signUp.Raw)
+		assert.Equal(t, "Backup@example.com", backup)
+		assert.Equal(t, "Jane@example.com", signUp.Contact.Email)
+		assert.Nil(t, signUp.Secondary)
+		assert.NoError(t, v.Struct(signUp))
+	})
+
+	t.Run("custom modifier", func(t *testing.T) {
+		type Profile struct {
+			Name string `mod:"upper,email_normalize"`
+		}
+		v, err := NewValidator()
+		require.NoError(t, err)
+		v.RegisterModifier("upper", func(value, _ string) string { return strings.ToUpper(value) })
+
+		profile := Profile{Name: " jane@example.com"}
+		require.NoError(t, v.Modify(&profile))
+		assert.Equal(t, "JANE@example.com", profile.Name)
+	})
+
+	t.Run("unknown modifier is a config error", func(t *testing.T) {
+		type Profile struct {
+			Name string `mod:"shout"`
+		}
+		err := v.Modify(&Profile{Name: "jane"})
+		var configErr *ConfigError
+		require.ErrorAs(t, err, &configErr)
+		assert.Equal(t, "mod", configErr.Tag)
+		assert.Equal(t, "shout", configErr.Param)
+	})
+
+	t.Run("requires a pointer to a struct", func(t *testing.T) {
+		var configErr *ConfigError
+		assert.ErrorAs(t, v.Modify(SignUp{}), &configErr)
+		assert.ErrorAs(t, v.Modify((*SignUp)(nil)), &configErr)
+	})
+}
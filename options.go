@@ -0,0 +1,171 @@
+package xvalidator
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"slices"
+	"time"
+)
+
+// Option configures a Validator created by NewValidator.
+type Option func(*options)
+
+// options holds the configuration shared by the rules registered through NewValidator.
+type options struct {
+	now          func() time.Time
+	resolver     Resolver
+	reachability *reachabilityChecker
+	emailMX      *mxChecker
+
+	disposableEmailDomains func() domainSet
+	freeEmailDomains       func() domainSet
+
+	patterns  *patternRegistry
+	wordList  func() WordList
+	passports *passportRegistry
+
+	passwordPolicies map[string]PasswordPolicy
+	commonPasswords  func() passwordSet
+	breachCheck      *breachChecker
+	passwordHistory  *passwordHistory
+
+	// registerDisabled also registers opt-in rules whose Option was not given, so that using them reports a
+	// ConfigError naming the Option. Only NewValidator sets it, because only its methods recover ConfigError.
+	registerDisabled bool
+}
+
+// Resolver looks up the IP addresses of a host. *net.Resolver satisfies it.
+type Resolver interface {
+	LookupIPAddr(ctx context.Context, host string) ([]net.IPAddr, error)
+}
+
+// defaultOptions returns the configuration used when no Option is given.
+func defaultOptions() options {
+	return options{
+		now:      time.Now,
+		resolver: net.DefaultResolver,
+
+		disposableEmailDomains: embeddedDisposableEmailDomains,
+		freeEmailDomains:       embeddedFreeEmailDomains,
+
+		patterns:  &patternRegistry{},
+		wordList:  defaultWordList,
+		passports: &passportRegistry{},
+
+		passwordPolicies: defaultPasswordPolicies(),
+		commonPasswords:  embeddedCommonPasswords,
+	}
+}
+
+// WithClock sets the function used to read the current time in time-dependent rules such as card_expiry
+// and future_date.
+// It is mainly useful in tests and when validating against a fixed reference time. A nil function is ignored.
+func WithClock(now func() time.Time) Option {
+	return func(o *options) {
+		if now != nil {
+			o.now = now
+		}
+	}
+}
+
+// WithResolver sets the resolver used by rules that look up host names, such as url_public=resolve.
+// Lookups receive the context passed to StructCtx or VarCtx. A nil resolver is ignored.
+func WithResolver(r Resolver) Option {
+	return func(o *options) {
+		if r != nil {
+			o.resolver = r
+		}
+	}
+}
+
+// WithURLReachability enables the url_reachable rule, which sends a HEAD request to the URL.
+// Each request is bounded by timeout, and requests are spaced at least interval apart across the validator.
+// Redirects are not followed. A nil client uses http.DefaultClient's transport; a timeout of zero or less
+// defaults to 5 seconds.
+func WithURLReachability(client *http.Client, timeout, interval time.Duration) Option {
+	return func(o *options) {
+		o.reachability = newReachabilityChecker(client, timeout, interval)
+	}
+}
+
+// WithEmailMX enables the email_mx rule, which checks that email domains have MX or address records.
+// Each lookup is bounded by timeout, and answers are cached per domain for 10 minutes. A nil resolver uses
+// net.DefaultResolver; a timeout of zero or less defaults to 3 seconds.
+func WithEmailMX(resolver MXResolver, timeout time.Duration) Option {
+	return func(o *options) {
+		o.emailMX = newMXChecker(resolver, timeout)
+	}
+}
+
+// WithDisposableEmailDomains replaces the embedded list used by email_not_disposable with domains.
+// Subdomains of listed domains also match; combine DisposableEmailDomains with your own entries to extend the list.
+func WithDisposableEmailDomains(domains []string) Option {
+	set := newDomainSet(domains)
+	return func(o *options) {
+		o.disposableEmailDomains = func() domainSet { return set }
+	}
+}
+
+// WithFreeEmailDomains replaces the embedded list of free email providers rejected by email_business with domains.
+// Subdomains of listed domains also match; combine FreeEmailDomains with your own entries to extend the list.
+func WithFreeEmailDomains(domains []string) Option {
+	set := newDomainSet(domains)
+	return func(o *options) {
+		o.freeEmailDomains = func() domainSet { return set }
+	}
+}
+
+// WithWordList sets the banned words checked by clean_text, replacing the embedded DefaultBannedWords.
+// Use NewWordList for a fixed list or implement WordList for another source. A nil list is ignored.
+func WithWordList(list WordList) Option {
+	return func(o *options) {
+		if list != nil {
+			o.wordList = func() WordList { return list }
+		}
+	}
+}
+
+// WithPasswordPolicy registers a named password policy referenced as password_strength=<name>.
+// Registering "" replaces the default policy and "strict" replaces the built-in strict policy.
+// The policy is copied, so changing it, including its BannedSubstrings, after the call has no effect.
+func WithPasswordPolicy(name string, policy PasswordPolicy) Option {
+	policy.BannedSubstrings = slices.Clone(policy.BannedSubstrings)
+	return func(o *options) {
+		o.passwordPolicies[name] = policy
+	}
+}
+
+// WithCommonPasswords replaces the embedded list used by password_not_common with passwords.
+// Entries are compared case-insensitively; combine CommonPasswords with your own entries to extend the list.
+func WithCommonPasswords(passwords []string) Option {
+	set := newPasswordSet(passwords)
+	return func(o *options) {
+		o.commonPasswords = func() passwordSet { return set }
+	}
+}
+
+// WithBreachCheck enables the password_not_breached rule, which looks passwords up in the Have I Been Pwned
+// range API using k-anonymity. Each request is bounded by timeout; a nil client uses http.DefaultClient and a
+// timeout of zero or less defaults to 3 seconds. When the API cannot be reached, failOpen accepts the password
+// (true) or rejects it (false).
+func WithBreachCheck(client *http.Client, timeout time.Duration, failOpen bool) Option {
+	return func(o *options) {
+		o.breachCheck = newBreachChecker(client, timeout, failOpen)
+	}
+}
+
+// WithPasswordHistory enables the password_not_reused rule, which asks checker whether a password matches one of
+// the user's last depth passwords. Checks receive the context passed to StructCtx or VarCtx. A depth of zero or
+// less defaults to 5; a nil checker is ignored.
+func WithPasswordHistory(checker PasswordHistoryChecker, depth int) Option {
+	return func(o *options) {
+		if checker == nil {
+			return
+		}
+		if depth <= 0 {
+			depth = defaultPasswordHistoryDepth
+		}
+		o.passwordHistory = &passwordHistory{checker: checker, depth: depth}
+	}
+}
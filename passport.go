@@ -0,0 +1,78 @@
+package xvalidator
+
+import (
+	"regexp"
+	"sync"
+)
+
+// passportFormats maps ISO 3166-1 alpha-2 country codes to the number formats of the passports they currently
+// issue (RE2 syntax, uppercase). Formats are matched against the whole passport number.
+var passportFormats = map[string]string{
+	"AT": `[A-Z][0-9]{7}`,
+	"AU": `[A-Z]{1,2}[0-9]{7}`,
+	"BE": `[A-Z]{2}[0-9]{6}`,
+	"BR": `[A-Z]{2}[0-9]{6}`,
+	"CA": `[A-Z]{2}[0-9]{6}`,
+	"CH": `[A-Z][0-9]{7}`,
+	"CN": `G[0-9]{8}|E[A-HJ-NP-Z0-9][0-9]{7}`,
+	"DE": `[CFGHJKLMNPRTVWXYZ0-9]{9}`,
+	"DK": `[0-9]{9}`,
+	"ES": `[A-Z0-9]{2,3}[0-9]{6}`,
+	"FI": `[A-Z]{2}[0-9]{7}`,
+	"FR": `[0-9]{2}[A-Z]{2}[0-9]{5}`,
+	"GB": `[0-9]{9}`,
+	"ID": `[A-CX][0-9]{7}`,
+	"IE": `[A-Z0-9]{2}[0-9]{7}`,
+	"IN": `[A-Z][0-9]{7}`,
+	"IT": `[A-Z0-9]{2}[0-9]{7}`,
+	"JP": `[A-Z]{2}[0-9]{7}`,
+	"KR": `[MSRGD][0-9]{8}|[MSRGD][0-9]{3}[A-Z][0-9]{4}`,
+	"MX": `[0-9]{10,11}|[A-Z][0-9]{8}`,
+	"MY": `[AHK][0-9]{8}`,
+	"NL": `[A-NP-Z]{2}[A-NP-Z0-9]{6}[0-9]`,
+	"NZ": `[A-Z]{1,2}[0-9]{6}`,
+	"PH": `[A-Z][0-9]{7}[A-Z]|[A-Z]{1,2}[0-9]{6,7}`,
+	"PL": `[A-Z]{2}[0-9]{7}`,
+	"PT": `[A-Z][0-9]{6}`,
+	"RU": `[0-9]{9}`,
+	"SE": `[0-9]{8}`,
+	"SG": `[A-Z][0-9]{7}[A-Z]`,
+	"TH": `[A-Z]{1,2}[0-9]{6,7}`,
+	"TR": `[A-Z][0-9]{8}`,
+	"UA": `[A-Z]{2}[0-9]{6}`,
+	"US": `[0-9]{9}|[A-Z][0-9]{8}`,
+	"ZA": `[TAMD][0-9]{8}`,
+}
+
+// genericPassportRegex matches ICAO 9303 document numbers of countries without a known format:
+// 6 to 9 uppercase letters and digits.
+var genericPassportRegex = lazyRegexCompile(`^[A-Z0-9]{6,9}$`)
+
+// anchoredPattern anchors a passport number format so it must match the whole number.
+func anchoredPattern(pattern string) string {
+	return `^(?:` + pattern + `)$`
+}
+
+// builtinPassportRegexes compiles passportFormats once, on first use.
+var builtinPassportRegexes = sync.OnceValue(func() map[string]*regexp.Regexp {
+	regexes := make(map[string]*regexp.Regexp, len(passportFormats))
+	for country, pattern := range passportFormats {
+		regexes[country] = regexp.MustCompile(anchoredPattern(pattern))
+	}
+	return regexes
+})
+
+// passportRegistry holds the passport number formats registered with Validator.RegisterPassportFormat,
+// which take precedence over the built-in formats.
+type passportRegistry struct {
+	formats sync.Map // country -> *regexp.Regexp
+}
+
+// lookup returns the passport number format of country, registered or built in.
+func (r *passportRegistry) lookup(country string) (*regexp.Regexp, bool) {
+	if regex, ok := r.formats.Load(country); ok {
+		return regex.(*regexp.Regexp), true
+	}
+	regex, ok := builtinPassportRegexes()[country]
+	return regex, ok
+}
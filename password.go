@@ -3,19 +3,105 @@ package xvalidator
 import (
 	"fmt"
 	"strings"
+	"unicode"
+	"unicode/utf8"
 )
 
-// ValidatePasswordStrength provides a public interface to validate password strength.
-// Returns an error if the password doesn't meet the requirements.
-func ValidatePasswordStrength(password string) error {
+// defaultSpecialChars are the characters counted as special when a PasswordPolicy does not set SpecialChars.
+const defaultSpecialChars = "!@#$%^&*()_+-=[]{}|;:,.<>?"
+
+// PasswordPolicy describes the requirements enforced by the password_strength rule.
+// Register named policies with WithPasswordPolicy and reference them as password_strength=<name>.
+type PasswordPolicy struct {
+	// MinLength is the minimum password length in characters (runes); 0 means no minimum.
+	MinLength int
+	// MaxLength is the maximum password length in characters (runes); 0 means no maximum.
+	MaxLength int
+	// RequireUpper requires at least one uppercase letter (A-Z).
+	RequireUpper bool
+	// RequireLower requires at least one lowercase letter (a-z).
+	RequireLower bool
+	// RequireDigit requires at least one digit (0-9).
+	RequireDigit bool
+	// RequireSpecial requires at least one character from SpecialChars.
+	RequireSpecial bool
+	// SpecialChars lists the characters counted as special; empty means "!@#$%^&*()_+-=[]{}|;:,.<>?".
+	SpecialChars string
+	// BannedSubstrings are rejected anywhere in the password, compared case-insensitively.
+	BannedSubstrings []string
+	// MaxSequenceLength is the longest allowed run of repeated ("aaaa") or sequential ("1234", "dcba")
+	// characters; 0 means no limit.
+	MaxSequenceLength int
+	// MaxKeyboardWalkLength is the longest allowed keyboard pattern, such as "qwerty" or "1qaz";
+	// 0 means no limit.
+	MaxKeyboardWalkLength int
+	// UnicodeClasses lets non-ASCII characters satisfy the character-class requirements: uppercase and
+	// lowercase letters of any script, letters of scripts without case (e.g., Thai) as either case,
+	// digits of any script, and Unicode punctuation and symbols as special characters.
+	UnicodeClasses bool
+	// PassphraseLength is the length in characters (runes) from which a password is treated as a passphrase
+	// and the character-class requirements no longer apply, following NIST SP 800-63B; 0 disables passphrases.
+	// Length limits, banned substrings and sequence checks still apply to passphrases.
+	PassphraseLength int
+}
+
+// DefaultPasswordPolicy returns the policy used by password_strength without a parameter and by
+// ValidatePasswordStrength: 8 to 100 characters with an uppercase letter, a lowercase letter, a digit and a
+// special character. Each call returns a new copy, so changing it does not affect the built-in rules; register
+// a modified copy with WithPasswordPolicy instead.
+func DefaultPasswordPolicy() PasswordPolicy {
+	return PasswordPolicy{
+		MinLength:      8,
+		MaxLength:      100,
+		RequireUpper:   true,
+		RequireLower:   true,
+		RequireDigit:   true,
+		RequireSpecial: true,
+	}
+}
+
+// StrictPasswordPolicy returns the built-in policy referenced as password_strength=strict: 12 to 128 characters
+// with an uppercase letter, a lowercase letter, a digit and a special character. Like DefaultPasswordPolicy,
+// each call returns a new copy.
+func StrictPasswordPolicy() PasswordPolicy {
+	return PasswordPolicy{
+		MinLength:      12,
+		MaxLength:      128,
+		RequireUpper:   true,
+		RequireLower:   true,
+		RequireDigit:   true,
+		RequireSpecial: true,
+	}
+}
+
+// defaultPasswordPolicies returns the named policies available without WithPasswordPolicy.
+func defaultPasswordPolicies() map[string]PasswordPolicy {
+	return map[string]PasswordPolicy{
+		"":       DefaultPasswordPolicy(),
+		"strict": StrictPasswordPolicy(),
+	}
+}
+
+// specialChars returns the characters counted as special by the policy.
+func (p PasswordPolicy) specialChars() string {
+	if p.SpecialChars == "" {
+		return defaultSpecialChars
+	}
+	return p.SpecialChars
+}
+
+// Validate checks password against the policy and returns an error describing the first unmet requirement.
+func (p PasswordPolicy) Validate(password string) error {
+	length := utf8.RuneCountInString(password)
+
 	// Check minimum length
-	if len(password) < 8 {
-		return fmt.Errorf("password must be at least 8 characters long")
+	if length < p.MinLength {
+		return fmt.Errorf("password must be at least %d characters long", p.MinLength)
 	}
 
 	// Check maximum length
-	if len(password) > 100 {
-		return fmt.Errorf("password must not exceed 100 characters")
+	if p.MaxLength > 0 && length > p.MaxLength {
+		return fmt.Errorf("password must not exceed %d characters", p.MaxLength)
 	}
 
 	hasUpper := false
@@ -23,8 +109,7 @@ func ValidatePasswordStrength(password string) error {
 	hasDigit := false
 	hasSpecial := false
 
-	// Define special characters
-	specialChars := "!@#$%^&*()_+-=[]{}|;:,.<>?"
+	specialChars := p.specialChars()
 
 	for _, char := range password {
 		switch {
@@ -36,20 +121,37 @@ func ValidatePasswordStrength(password string) error {
 			hasDigit = true
 		case strings.ContainsRune(specialChars, char):
 			hasSpecial = true
+		case !p.UnicodeClasses || char < utf8.RuneSelf:
+		case unicode.IsUpper(char):
+			hasUpper = true
+		case unicode.IsLower(char):
+			hasLower = true
+		case unicode.IsLetter(char):
+			// Scripts without case, such as Thai, satisfy either case requirement
+			hasUpper, hasLower = true, true
+		case unicode.IsDigit(char):
+			hasDigit = true
+		case unicode.IsPunct(char) || unicode.IsSymbol(char):
+			hasSpecial = true
 		}
 	}
 
+	// Long passphrases are strong through length alone
+	if p.PassphraseLength > 0 && length >= p.PassphraseLength {
+		hasUpper, hasLower, hasDigit, hasSpecial = true, true, true, true
+	}
+
 	var missing []string
-	if !hasUpper {
+	if p.RequireUpper && !hasUpper {
 		missing = append(missing, "uppercase letter")
 	}
-	if !hasLower {
+	if p.RequireLower && !hasLower {
 		missing = append(missing, "lowercase letter")
 	}
-	if !hasDigit {
+	if p.RequireDigit && !hasDigit {
 		missing = append(missing, "digit")
 	}
-	if !hasSpecial {
+	if p.RequireSpecial && !hasSpecial {
 		missing = append(missing, "special character ("+specialChars+")")
 	}
 
@@ -57,5 +159,195 @@ func ValidatePasswordStrength(password string) error {
 		return fmt.Errorf("password must contain at least one: %s", strings.Join(missing, ", "))
 	}
 
+	lowered := strings.ToLower(password)
+	for _, banned := range p.BannedSubstrings {
+		if banned != "" && strings.Contains(lowered, strings.ToLower(banned)) {
+			return fmt.Errorf("password must not contain %q", banned)
+		}
+	}
+
+	if p.MaxSequenceLength > 0 && longestPasswordSequence(password) > p.MaxSequenceLength {
+		return fmt.Errorf("password must not contain more than %d repeated or sequential characters", p.MaxSequenceLength)
+	}
+
+	if p.MaxKeyboardWalkLength > 0 && longestKeyboardWalk(password) > p.MaxKeyboardWalkLength {
+		return fmt.Errorf("password must not contain keyboard patterns of more than %d keys", p.MaxKeyboardWalkLength)
+	}
+
 	return nil
 }
+
+// describe returns the requirements of the policy as used in translated error messages,
+// e.g. "must contain at least 8 characters with: uppercase letter (A-Z), ... and special character (...)".
+func (p PasswordPolicy) describe() string {
+	var classes []string
+	if p.UnicodeClasses {
+		if p.RequireUpper {
+			classes = append(classes, "uppercase letter")
+		}
+		if p.RequireLower {
+			classes = append(classes, "lowercase letter")
+		}
+		if p.RequireDigit {
+			classes = append(classes, "digit")
+		}
+		if p.RequireSpecial {
+			classes = append(classes, "special character")
+		}
+	} else {
+		if p.RequireUpper {
+			classes = append(classes, "uppercase letter (A-Z)")
+		}
+		if p.RequireLower {
+			classes = append(classes, "lowercase letter (a-z)")
+		}
+		if p.RequireDigit {
+			classes = append(classes, "digit (0-9)")
+		}
+		if p.RequireSpecial {
+			classes = append(classes, "special character ("+p.specialChars()+")")
+		}
+	}
+
+	description := fmt.Sprintf("must contain at least %d characters", p.MinLength)
+	switch len(classes) {
+	case 0:
+	case 1:
+		description += " with: " + classes[0]
+	default:
+		description += " with: " + strings.Join(classes[:len(classes)-1], ", ") + ", and " + classes[len(classes)-1]
+	}
+	if p.PassphraseLength > 0 && len(classes) > 0 {
+		description += fmt.Sprintf(", or at least %d characters as a passphrase", p.PassphraseLength)
+	}
+	if len(p.BannedSubstrings) > 0 {
+		description += ", and must not contain banned words"
+	}
+	if p.MaxSequenceLength > 0 {
+		description += fmt.Sprintf(", and must not contain more than %d repeated or sequential characters", p.MaxSequenceLength)
+	}
+	if p.MaxKeyboardWalkLength > 0 {
+		description += fmt.Sprintf(", and must not contain keyboard patterns of more than %d keys", p.MaxKeyboardWalkLength)
+	}
+	return description
+}
+
+// sequenceClass returns the class within which consecutive characters form a sequence:
+// 'a' for ASCII letters (case-insensitive), '0' for digits, or 0 for characters never part of a sequence.
+func sequenceClass(r rune) rune {
+	switch {
+	case r >= 'a' && r <= 'z':
+		return 'a'
+	case r >= '0' && r <= '9':
+		return '0'
+	default:
+		return 0
+	}
+}
+
+// longestPasswordSequence returns the length of the longest run of repeated characters ("aaaa") or of
+// ascending or descending letters or digits ("abcd", "4321") in password, ignoring case.
+func longestPasswordSequence(password string) int {
+	runes := []rune(strings.ToLower(password))
+	if len(runes) == 0 {
+		return 0
+	}
+
+	longest, repeated, ascending, descending := 1, 1, 1, 1
+	for i := 1; i < len(runes); i++ {
+		prev, cur := runes[i-1], runes[i]
+		sequential := sequenceClass(prev) != 0 && sequenceClass(prev) == sequenceClass(cur)
+
+		repeated = nextRunLength(repeated, cur == prev)
+		ascending = nextRunLength(ascending, sequential && cur == prev+1)
+		descending = nextRunLength(descending, sequential && cur == prev-1)
+		longest = max(longest, repeated, ascending, descending)
+	}
+	return longest
+}
+
+// nextRunLength extends a run by one character when it continues, or starts a new run of one.
+func nextRunLength(length int, continues bool) int {
+	if continues {
+		return length + 1
+	}
+	return 1
+}
+
+// keyPosition is the row and column of a key on a US QWERTY keyboard. Columns follow the usual row stagger,
+// so keys sharing a column ("1", "q", "a", "z") form a diagonal line on the keyboard.
+type keyPosition struct {
+	row, col int
+}
+
+// keyboardRows are the unshifted and shifted characters of each US QWERTY row.
+var keyboardRows = [][2]string{
+	{"`1234567890-=", "~!@#$%^&*()_+"},
+	{"qwertyuiop[]\\", "QWERTYUIOP{}|"},
+	{"asdfghjkl;'", "ASDFGHJKL:\""},
+	{"zxcvbnm,./", "ZXCVBNM<>?"},
+}
+
+// keyboardPositions maps each character of keyboardRows to its key position. Row 0 starts one column
+// to the left so that "1" sits above "q".
+var keyboardPositions = func() map[rune]keyPosition {
+	positions := make(map[rune]keyPosition)
+	for row, keys := range keyboardRows {
+		offset := 0
+		if row == 0 {
+			offset = -1
+		}
+		for _, layer := range keys {
+			for col, r := range []rune(layer) {
+				positions[r] = keyPosition{row: row, col: col + offset}
+			}
+		}
+	}
+	return positions
+}()
+
+// isKeyboardStep reports whether moving from one key to the next follows a straight line on the keyboard:
+// along a row ("qwer"), down a column ("1qaz") or along the opposite diagonal ("4esz"), in either direction.
+func isKeyboardStep(dRow, dCol int) bool {
+	switch {
+	case dRow == 0:
+		return dCol == 1 || dCol == -1
+	case dRow == 1 || dRow == -1:
+		return dCol == 0 || dCol == -dRow
+	default:
+		return false
+	}
+}
+
+// longestKeyboardWalk returns the number of keys in the longest straight keyboard pattern in password,
+// such as "qwerty", "asdfgh", "1qaz" or "zaq1". "1qaz2wsx" counts as two patterns of 4 keys.
+func longestKeyboardWalk(password string) int {
+	longest, length := 0, 0
+	var prev keyPosition
+	var dRow, dCol int
+	for _, r := range password {
+		pos, ok := keyboardPositions[r]
+		switch {
+		case !ok:
+			length = 0
+		case length == 0:
+			length = 1
+		case length >= 2 && pos.row-prev.row == dRow && pos.col-prev.col == dCol:
+			length++
+		case isKeyboardStep(pos.row-prev.row, pos.col-prev.col):
+			dRow, dCol = pos.row-prev.row, pos.col-prev.col
+			length = 2
+		default:
+			length = 1
+		}
+		prev = pos
+		longest = max(longest, length)
+	}
+	return longest
+}
+
+// ValidatePasswordStrength provides a public interface to validate password strength.
+// Returns an error if the password doesn't meet the requirements of DefaultPasswordPolicy.
+func ValidatePasswordStrength(password string) error {
+	return DefaultPasswordPolicy().Validate(password)
+}
@@ -0,0 +1,101 @@
+package xvalidator
+
+import (
+	"bufio"
+	"context"
+	"crypto/sha1"
+	"encoding/hex"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/go-playground/validator/v10"
+)
+
+// pwnedPasswordsRangeURL is the Have I Been Pwned k-anonymity range endpoint; the first five characters
+// of the password's SHA-1 hash are appended.
+const pwnedPasswordsRangeURL = "https://api.pwnedpasswords.com/range/"
+
+// defaultBreachCheckTimeout bounds password_not_breached requests when WithBreachCheck gets no timeout.
+const defaultBreachCheckTimeout = 3 * time.Second
+
+// breachChecker queries the Have I Been Pwned range API for the password_not_breached rule.
+type breachChecker struct {
+	client   *http.Client
+	endpoint string
+	timeout  time.Duration
+	failOpen bool
+}
+
+// newBreachChecker creates a breachChecker; see WithBreachCheck for the defaults.
+func newBreachChecker(client *http.Client, timeout time.Duration, failOpen bool) *breachChecker {
+	if client == nil {
+		client = http.DefaultClient
+	}
+	if timeout <= 0 {
+		timeout = defaultBreachCheckTimeout
+	}
+	return &breachChecker{client: client, endpoint: pwnedPasswordsRangeURL, timeout: timeout, failOpen: failOpen}
+}
+
+// breached reports whether password appears in the Have I Been Pwned corpus. Only the first five characters
+// of its SHA-1 hash are sent. ok is false when the API could not be queried.
+func (c *breachChecker) breached(ctx context.Context, password string) (breached, ok bool) {
+	sum := sha1.Sum([]byte(password))
+	hash := strings.ToUpper(hex.EncodeToString(sum[:]))
+	prefix, suffix := hash[:5], hash[5:]
+
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.endpoint+prefix, nil)
+	if err != nil {
+		return false, false
+	}
+	// Padding hides the number of matching suffixes from anyone observing the response size
+	req.Header.Set("Add-Padding", "true")
+	req.Header.Set("User-Agent", "go-xvalidator")
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return false, false
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return false, false
+	}
+
+	// Each line is "SUFFIX:COUNT"; padding entries have a count of 0
+	scanner := bufio.NewScanner(resp.Body)
+	for scanner.Scan() {
+		candidate, count, found := strings.Cut(strings.TrimSpace(scanner.Text()), ":")
+		if found && strings.EqualFold(candidate, suffix) {
+			return count != "0", true
+		}
+	}
+	if scanner.Err() != nil {
+		return false, false
+	}
+	return false, true
+}
+
+// validatePasswordNotBreached returns a validator rejecting passwords found in the Have I Been Pwned
+// breach corpus, using the context passed to StructCtx or VarCtx. The password never leaves the process:
+// only the first five characters of its SHA-1 hash are sent (k-anonymity).
+// The rule is opt-in: without WithBreachCheck it reports a ConfigError. When the API cannot be reached,
+// the password is accepted or rejected according to the failOpen setting of WithBreachCheck.
+// Usage:
+//   - `validate:"password_strength,password_not_breached"` - check new passwords at registration
+func validatePasswordNotBreached(checker *breachChecker) validator.FuncCtx {
+	return func(ctx context.Context, fl validator.FieldLevel) bool {
+		if checker == nil {
+			panicConfigError(fl, "password_not_breached must be enabled with WithBreachCheck")
+		}
+
+		breached, ok := checker.breached(ctx, fl.Field().String())
+		if !ok {
+			return checker.failOpen
+		}
+		return !breached
+	}
+}
@@ -0,0 +1,111 @@
+package xvalidator
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// rewriteTransport sends every request to target, keeping the path.
+type rewriteTransport struct {
+	target *url.URL
+}
+
+func (t rewriteTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	req = req.Clone(req.Context())
+	req.URL.Scheme = t.target.Scheme
+	req.URL.Host = t.target.Host
+	return http.DefaultTransport.RoundTrip(req)
+}
+
+func TestPasswordNotBreached(t *testing.T) {
+	// SHA-1("P@ssw0rd") = 21BD12DC183F740EE76F27B78EB39C8AD972A757
+	var (
+		mu                  sync.Mutex
+		gotPath, gotPadding string
+	)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		gotPath, gotPadding = r.URL.Path, r.Header.Get("Add-Padding")
+		mu.Unlock()
+		switch r.URL.Path {
+		case "/range/21BD1":
+			w.Write([]byte("0018A45C4D1DEF81644B54AB7F969B88D65:1\r\n2DC183F740EE76F27B78EB39C8AD972A757:52579\r\n"))
+		case "/range/0F58E":
+			// SHA-1("Tr0ub4dor&3-horse") listed as a padding entry
+			w.Write([]byte("947D95C7192CC0EED07F1EAA1875CBF160D:0\r\n"))
+		case "/range/ABF7A":
+			// SHA-1("correct horse battery staple") answers too slowly
+			time.Sleep(200 * time.Millisecond)
+		default:
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}
+	}))
+	defer server.Close()
+
+	target, err := url.Parse(server.URL)
+	require.NoError(t, err)
+	client := &http.Client{Transport: rewriteTransport{target: target}}
+
+	v, err := NewValidator(WithBreachCheck(client, 50*time.Millisecond, false))
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	t.Run("breached password", func(t *testing.T) {
+		assert.Error(t, v.VarCtx(ctx, "P@ssw0rd", "password_not_breached"))
+
+		mu.Lock()
+		defer mu.Unlock()
+		assert.Equal(t, "/range/21BD1", gotPath)
+		assert.Equal(t, "true", gotPadding)
+	})
+
+	t.Run("padding entry is not a breach", func(t *testing.T) {
+		assert.NoError(t, v.VarCtx(ctx, "Tr0ub4dor&3-horse", "password_not_breached"))
+	})
+
+	t.Run("translated error", func(t *testing.T) {
+		err := v.VarTranslatedCtx(ctx, "P@ssw0rd", "password_not_breached")
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "has appeared in a data breach and must not be used")
+	})
+
+	t.Run("fail closed on API errors", func(t *testing.T) {
+		// SHA-1 prefixes without a handler answer 503
+		assert.Error(t, v.VarCtx(ctx, "Tr0ub4dor&3", "password_not_breached"))
+	})
+
+	t.Run("timeout fails closed", func(t *testing.T) {
+		assert.Error(t, v.VarCtx(ctx, "correct horse battery staple", "password_not_breached"))
+	})
+
+	t.Run("fail open on API errors", func(t *testing.T) {
+		open, err := NewValidator(WithBreachCheck(client, 50*time.Millisecond, true))
+		require.NoError(t, err)
+		assert.NoError(t, open.VarCtx(ctx, "Tr0ub4dor&3", "password_not_breached"))
+		assert.Error(t, open.VarCtx(ctx, "P@ssw0rd", "password_not_breached"))
+	})
+
+	t.Run("canceled context fails closed", func(t *testing.T) {
+		canceled, cancel := context.WithCancel(ctx)
+		cancel()
+		assert.Error(t, v.VarCtx(canceled, "Tr0ub4dor&3-horse", "password_not_breached"))
+	})
+
+	t.Run("not enabled is a config error", func(t *testing.T) {
+		plain, err := NewValidator()
+		require.NoError(t, err)
+
+		err = plain.VarCtx(ctx, "P@ssw0rd", "password_not_breached")
+		var configErr *ConfigError
+		require.ErrorAs(t, err, &configErr)
+		assert.Equal(t, "password_not_breached", configErr.Tag)
+	})
+}
@@ -0,0 +1,73 @@
+package xvalidator
+
+import (
+	_ "embed"
+	"strings"
+	"sync"
+	"unicode"
+)
+
+// commonPasswordsData is the embedded list of the most common passwords, one per line.
+// Lines starting with "#" are comments.
+//
+//go:embed common_passwords.txt
+var commonPasswordsData string
+
+// passwordSet is a set of lower-cased passwords.
+type passwordSet map[string]struct{}
+
+// newPasswordSet builds a passwordSet from passwords, ignoring empty entries.
+func newPasswordSet(passwords []string) passwordSet {
+	set := make(passwordSet, len(passwords))
+	for _, password := range passwords {
+		if password = strings.ToLower(strings.TrimSpace(password)); password != "" {
+			set[password] = struct{}{}
+		}
+	}
+	return set
+}
+
+// embeddedCommonPasswords parses the embedded list once, on first use.
+var embeddedCommonPasswords = sync.OnceValue(func() passwordSet {
+	return newPasswordSet(CommonPasswords())
+})
+
+// CommonPasswords returns the embedded list of common passwords, most frequent first.
+// Use it with WithCommonPasswords to extend the list with application-specific entries.
+func CommonPasswords() []string {
+	var passwords []string
+	for _, line := range strings.Split(commonPasswordsData, "\n") {
+		if line = strings.TrimSpace(line); line != "" && !strings.HasPrefix(line, "#") {
+			passwords = append(passwords, line)
+		}
+	}
+	return passwords
+}
+
+// minCommonPasswordCore is the shortest decorated core, such as "dragon" in "Dragon2026!", checked against the list.
+const minCommonPasswordCore = 4
+
+// contains reports whether password is in the set, ignoring case and common decorations: trailing symbols
+// ("123456!") and leading or trailing digits and symbols around a word ("Password123!").
+func (s passwordSet) contains(password string) bool {
+	lowered := strings.ToLower(password)
+	if _, ok := s[lowered]; ok {
+		return true
+	}
+
+	withoutSymbols := strings.TrimRightFunc(lowered, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+	if _, ok := s[withoutSymbols]; ok {
+		return true
+	}
+
+	core := strings.TrimFunc(lowered, func(r rune) bool {
+		return !unicode.IsLetter(r)
+	})
+	if len(core) < minCommonPasswordCore {
+		return false
+	}
+	_, ok := s[core]
+	return ok
+}
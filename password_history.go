@@ -0,0 +1,67 @@
+package xvalidator
+
+import (
+	"context"
+	"reflect"
+	"strconv"
+
+	"github.com/go-playground/validator/v10"
+)
+
+// defaultPasswordHistoryDepth is the number of previous passwords checked when WithPasswordHistory gets no depth.
+const defaultPasswordHistoryDepth = 5
+
+// PasswordHistoryChecker looks up a user's previous passwords for the password_not_reused rule.
+// Implementations typically load the user's stored password hashes and compare password against each
+// with the same algorithm used to store them (e.g., bcrypt.CompareHashAndPassword).
+type PasswordHistoryChecker interface {
+	// PasswordReused reports whether password matches any of the last n passwords of user.
+	PasswordReused(ctx context.Context, user, password string, n int) (bool, error)
+}
+
+// passwordHistory is the PasswordHistoryChecker and depth configured with WithPasswordHistory.
+type passwordHistory struct {
+	checker PasswordHistoryChecker
+	depth   int
+}
+
+// userIdentifier returns the string form of a user identifier field holding a string or an integer.
+func userIdentifier(field reflect.Value) (string, bool) {
+	switch field.Kind() {
+	case reflect.String:
+		return field.String(), field.String() != ""
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(field.Int(), 10), true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return strconv.FormatUint(field.Uint(), 10), true
+	default:
+		return "", false
+	}
+}
+
+// validatePasswordNotReused returns a validator rejecting a password that matches one of the user's previous
+// passwords, as reported by the PasswordHistoryChecker, using the context passed to StructCtx or VarCtx.
+// The parameter names the sibling field holding the user identifier (a string or an integer).
+// The rule is opt-in: without WithPasswordHistory it reports a ConfigError. Checker errors and a missing
+// user identifier reject the password.
+// Usage:
+//   - `validate:"password_not_reused=UserID"` - in change-password requests
+func validatePasswordNotReused(history *passwordHistory) validator.FuncCtx {
+	return func(ctx context.Context, fl validator.FieldLevel) bool {
+		if history == nil {
+			panicConfigError(fl, "password_not_reused must be enabled with WithPasswordHistory")
+		}
+
+		userField, found := lookupFieldPath(fl.Parent(), fl.Param())
+		if !found {
+			panicConfigError(fl, "user references a field that does not exist")
+		}
+		user, ok := userIdentifier(userField)
+		if !ok {
+			return false
+		}
+
+		reused, err := history.checker.PasswordReused(ctx, user, fl.Field().String(), history.depth)
+		return err == nil && !reused
+	}
+}
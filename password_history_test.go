@@ -0,0 +1,130 @@
+package xvalidator
+
+import (
+	"context"
+	"crypto/sha256"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakePasswordHistory stores password hashes per user, most recent first.
+type fakePasswordHistory struct {
+	hashes map[string][][32]byte
+	err    error
+	gotN   int
+}
+
+func (f *fakePasswordHistory) PasswordReused(ctx context.Context, user, password string, n int) (bool, error) {
+	if f.err != nil {
+		return false, f.err
+	}
+	if err := ctx.Err(); err != nil {
+		return false, err
+	}
+	f.gotN = n
+
+	hashes := f.hashes[user]
+	hash := sha256.Sum256([]byte(password))
+	for i := 0; i < len(hashes) && i < n; i++ {
+		if hashes[i] == hash {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func TestPasswordNotReused(t *testing.T) {
+	history := &fakePasswordHistory{hashes: map[string][][32]byte{
+		"42": {
+			sha256.Sum256([]byte("Winter2026!")),
+			sha256.Sum256([]byte("Autumn2025!")),
+			sha256.Sum256([]byte("Summer2025!")),
+		},
+	}}
+	v, err := NewValidator(WithPasswordHistory(history, 2))
+	require.NoError(t, err)
+
+	type ChangePassword struct {
+		UserID      int
+		NewPassword string `validate:"password_not_reused=UserID"`
+	}
+
+	ctx := context.Background()
+	tests := []struct {
+		name    string
+		input   ChangePassword
+		wantErr bool
+	}{
+		{"new password", ChangePassword{UserID: 42, NewPassword: "Spring2026!"}, false},
+		{"current password", ChangePassword{UserID: 42, NewPassword: "Winter2026!"}, true},
+		{"previous password within depth", ChangePassword{UserID: 42, NewPassword: "Autumn2025!"}, true},
+		{"previous password beyond depth", ChangePassword{UserID: 42, NewPassword: "Summer2025!"}, false},
+		{"other user", ChangePassword{UserID: 7, NewPassword: "Winter2026!"}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := v.StructCtx(ctx, tt.input)
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, 2, history.gotN)
+		})
+	}
+
+	t.Run("string user identifier", func(t *testing.T) {
+		type Request struct {
+			Username string
+			Password string `validate:"password_not_reused=Username"`
+		}
+
+		assert.Error(t, v.StructCtx(ctx, Request{Username: "42", Password: "Winter2026!"}))
+		assert.Error(t, v.StructCtx(ctx, Request{Username: "", Password: "Spring2026!"}), "empty user is rejected")
+	})
+
+	t.Run("translated error", func(t *testing.T) {
+		err := v.StructTranslatedCtx(ctx, ChangePassword{UserID: 42, NewPassword: "Winter2026!"})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "NewPassword must not match a recently used password")
+	})
+
+	t.Run("checker errors reject the password", func(t *testing.T) {
+		failing, err := NewValidator(WithPasswordHistory(&fakePasswordHistory{err: errors.New("db down")}, 0))
+		require.NoError(t, err)
+		assert.Error(t, failing.StructCtx(ctx, ChangePassword{UserID: 42, NewPassword: "Spring2026!"}))
+
+		canceled, cancel := context.WithCancel(ctx)
+		cancel()
+		assert.Error(t, v.StructCtx(canceled, ChangePassword{UserID: 42, NewPassword: "Spring2026!"}))
+	})
+
+	t.Run("default depth", func(t *testing.T) {
+		checker := &fakePasswordHistory{}
+		defaults, err := NewValidator(WithPasswordHistory(checker, 0))
+		require.NoError(t, err)
+		require.NoError(t, defaults.StructCtx(ctx, ChangePassword{UserID: 1, NewPassword: "Spring2026!"}))
+		assert.Equal(t, 5, checker.gotN)
+	})
+
+	t.Run("config errors", func(t *testing.T) {
+		plain, err := NewValidator()
+		require.NoError(t, err)
+
+		var configErr *ConfigError
+		err = plain.StructCtx(ctx, ChangePassword{UserID: 42, NewPassword: "Spring2026!"})
+		require.ErrorAs(t, err, &configErr)
+		assert.Equal(t, "password_not_reused", configErr.Tag)
+
+		type Misconfigured struct {
+			Password string `validate:"password_not_reused=UserID"`
+		}
+		err = v.StructCtx(ctx, Misconfigured{Password: "Spring2026!"})
+		require.ErrorAs(t, err, &configErr)
+		assert.Equal(t, "password_not_reused", configErr.Tag)
+	})
+}
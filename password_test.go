@@ -3,6 +3,10 @@ package xvalidator
 import (
 	"strings"
 	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestValidatePasswordStrength(t *testing.T) {
@@ -209,6 +213,117 @@ func TestValidatePasswordStrength_EdgeCases(t *testing.T) {
 	})
 }
 
+func TestPasswordPolicy_Validate(t *testing.T) {
+	policy := PasswordPolicy{
+		MinLength:        10,
+		MaxLength:        20,
+		RequireLower:     true,
+		RequireDigit:     true,
+		RequireSpecial:   true,
+		SpecialChars:     "~-",
+		BannedSubstrings: []string{"acme", "Welcome"},
+	}
+
+	tests := []struct {
+		name     string
+		password string
+		errMsg   string
+	}{
+		{"meets policy", "correct~horse1", ""},
+		{"uppercase not required", "lowercase-only1", ""},
+		{"too short", "short~1", "at least 10 characters"},
+		{"too long", "this~password~is~too~long1", "must not exceed 20 characters"},
+		{"default special chars not counted", "correct!horse1", "special character (~-)"},
+		{"missing digit", "correct~horse", "digit"},
+		{"banned substring", "my~acme~pass1", `must not contain "acme"`},
+		{"banned substring case insensitive", "WELCOME~back12", `must not contain "Welcome"`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := policy.Validate(tt.password)
+			if tt.errMsg == "" {
+				assert.NoError(t, err)
+				return
+			}
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.errMsg)
+		})
+	}
+
+	t.Run("zero max length means unlimited", func(t *testing.T) {
+		assert.NoError(t, PasswordPolicy{MinLength: 1}.Validate(strings.Repeat("x", 1000)))
+	})
+}
+
+func TestPasswordStrength_NamedPolicies(t *testing.T) {
+	pinPolicy := PasswordPolicy{MinLength: 6, MaxLength: 6, RequireDigit: true}
+	v, err := NewValidator(WithPasswordPolicy("kiosk", pinPolicy))
+	require.NoError(t, err)
+
+	tests := []struct {
+		name     string
+		password string
+		tag      string
+		wantErr  bool
+	}{
+		{"default policy", "Test123!", "password_strength", false},
+		{"strict policy accepts long password", "Test1234!abc", "password_strength=strict", false},
+		{"strict policy rejects short password", "Test123!", "password_strength=strict", true},
+		{"custom policy", "123456", "password_strength=kiosk", false},
+		{"custom policy rejects long password", "1234567", "password_strength=kiosk", true},
+		{"default policy unaffected by custom policy", "123456", "password_strength", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := v.Var(tt.password, tt.tag)
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+
+	t.Run("translated message describes policy", func(t *testing.T) {
+		err := v.VarTranslated("Test123!", "password_strength=strict")
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "must contain at least 12 characters with: uppercase letter (A-Z), lowercase letter (a-z), digit (0-9), and special character")
+
+		err = v.VarTranslated("12345", "password_strength=kiosk")
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "must contain at least 6 characters with: digit (0-9)")
+	})
+
+	t.Run("replacing the default policy", func(t *testing.T) {
+		relaxed, err := NewValidator(WithPasswordPolicy("", PasswordPolicy{MinLength: 4}))
+		require.NoError(t, err)
+		assert.NoError(t, relaxed.Var("abcd", "password_strength"))
+	})
+
+	t.Run("policies are copied", func(t *testing.T) {
+		policy := DefaultPasswordPolicy()
+		policy.MinLength = 4
+		assert.Equal(t, 8, DefaultPasswordPolicy().MinLength)
+
+		banned := PasswordPolicy{MinLength: 4, BannedSubstrings: []string{"acme"}}
+		option := WithPasswordPolicy("banned", banned)
+		banned.BannedSubstrings[0] = "zzzz"
+		copied, err := NewValidator(option)
+		require.NoError(t, err)
+		assert.Error(t, copied.Var("myacme1", "password_strength=banned"))
+		assert.NoError(t, copied.Var("zzzz", "password_strength=banned"))
+	})
+
+	t.Run("unknown policy", func(t *testing.T) {
+		var configErr *ConfigError
+		err := v.Var("Test123!", "password_strength=missing")
+		require.ErrorAs(t, err, &configErr)
+		assert.Equal(t, "password_strength", configErr.Tag)
+	})
+}
+
 func BenchmarkValidatePasswordStrength(b *testing.B) {
 	passwords := []string{
 		"Test1234!",
@@ -227,3 +342,419 @@ func BenchmarkValidatePasswordStrength(b *testing.B) {
 		})
 	}
 }
+
+func TestPasswordNotCommon(t *testing.T) {
+	v, err := NewValidator()
+	require.NoError(t, err)
+
+	tests := []struct {
+		name     string
+		password string
+		wantErr  bool
+	}{
+		{"common password", "password", true},
+		{"common numeric password", "123456", true},
+		{"case insensitive", "QWERTY", true},
+		{"decorated common password passes complexity check", "Password123!", true},
+		{"trailing symbols", "123456!", true},
+		{"leading digits", "2026dragon", true},
+		{"uncommon password", "Tr0ub4dor&3-horse", false},
+		{"short core not checked", "Ab1!", false},
+		{"uncommon passphrase", "correct horse battery staple", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := v.Var(tt.password, "password_not_common")
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+
+	t.Run("combined with password_strength", func(t *testing.T) {
+		assert.NoError(t, v.Var("Password123!", "password_strength"))
+		assert.Error(t, v.Var("Password123!", "password_strength,password_not_common"))
+	})
+}
+
+func TestPasswordNotCommon_CustomList(t *testing.T) {
+	v, err := NewValidator(WithCommonPasswords(append(CommonPasswords(), "AcmeCorp")))
+	require.NoError(t, err)
+
+	assert.Error(t, v.Var("acmecorp2026!", "password_not_common"))
+	assert.Error(t, v.Var("password", "password_not_common"))
+
+	replaced, err := NewValidator(WithCommonPasswords([]string{"hunter2"}))
+	require.NoError(t, err)
+
+	assert.Error(t, replaced.Var("Hunter2", "password_not_common"))
+	assert.NoError(t, replaced.Var("password", "password_not_common"))
+}
+
+func TestCommonPasswords(t *testing.T) {
+	passwords := CommonPasswords()
+	require.NotEmpty(t, passwords)
+	assert.Equal(t, "password", passwords[0])
+	for _, password := range passwords {
+		assert.NotContains(t, password, "#")
+	}
+}
+
+func TestPasswordNotContains(t *testing.T) {
+	v, err := NewValidator()
+	require.NoError(t, err)
+
+	type Account struct {
+		Username string
+		Email    string
+		Password string `validate:"password_not_contains=Username Email"`
+	}
+
+	tests := []struct {
+		name    string
+		account Account
+		wantErr bool
+	}{
+		{"unrelated password", Account{"somchai", "somchai.j@example.com", "Tr0ub4dor&3"}, false},
+		{"contains username", Account{"somchai", "s.j@example.com", "MySomchai#1"}, true},
+		{"contains username case-insensitively", Account{"SomChai", "s.j@example.com", "xsomchaix"}, true},
+		{"contains full email", Account{"sj", "s.j@example.com", "S.J@Example.com!"}, true},
+		{"contains email local part", Account{"sj", "john.doe@example.com", "John.Doe2026!"}, true},
+		{"short values ignored", Account{"sj", "", "sjPassw0rd!"}, false},
+		{"empty values ignored", Account{"", "", "Tr0ub4dor&3"}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := v.Validate(tt.account)
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+
+	t.Run("unknown field is a config error", func(t *testing.T) {
+		type Misconfigured struct {
+			Password string `validate:"password_not_contains=Login"`
+		}
+
+		err := v.Validate(Misconfigured{Password: "Tr0ub4dor&3"})
+		var configErr *ConfigError
+		require.ErrorAs(t, err, &configErr)
+		assert.Equal(t, "password_not_contains", configErr.Tag)
+	})
+}
+
+func TestPasswordNoSequences(t *testing.T) {
+	v, err := NewValidator()
+	require.NoError(t, err)
+
+	tests := []struct {
+		name     string
+		password string
+		tag      string
+		wantErr  bool
+	}{
+		{"no runs", "Tr0ub4dor&3", "password_no_sequences", false},
+		{"run of 3 allowed", "Xy#123!aaa", "password_no_sequences", false},
+		{"repeated characters", "Xyaaaa#1", "password_no_sequences", true},
+		{"repeated symbols", "Xy!!!!#1", "password_no_sequences", true},
+		{"ascending digits", "Xy#1234!", "password_no_sequences", true},
+		{"descending digits", "Xy#9876!", "password_no_sequences", true},
+		{"ascending letters", "abcd#1X", "password_no_sequences", true},
+		{"letters case-insensitive", "aBcD#1X", "password_no_sequences", true},
+		{"descending letters", "Zdcba#1", "password_no_sequences", true},
+		{"digit to letter is not a sequence", "X789a#1", "password_no_sequences", false},
+		{"symbols are not sequential", "X#$%&1y", "password_no_sequences", false},
+		{"custom length allows longer runs", "Xy#12345!", "password_no_sequences=5", false},
+		{"custom length rejects", "Xy#aa!", "password_no_sequences=1", true},
+		{"empty password", "", "password_no_sequences", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := v.Var(tt.password, tt.tag)
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+
+	t.Run("invalid parameter is a config error", func(t *testing.T) {
+		err := v.Var("Xy#1!", "password_no_sequences=x")
+		var configErr *ConfigError
+		require.ErrorAs(t, err, &configErr)
+		assert.Equal(t, "password_no_sequences", configErr.Tag)
+	})
+}
+
+func TestPasswordPolicy_MaxSequenceLength(t *testing.T) {
+	policy := DefaultPasswordPolicy()
+	policy.MaxSequenceLength = 3
+
+	assert.NoError(t, policy.Validate("Tr0ub4dor&3"))
+	err := policy.Validate("Passw0rd1234!")
+	require.Error(t, err)
+	assert.Equal(t, "password must not contain more than 3 repeated or sequential characters", err.Error())
+	assert.Contains(t, policy.describe(), "must not contain more than 3 repeated or sequential characters")
+}
+
+func TestPasswordNoKeyboardWalk(t *testing.T) {
+	v, err := NewValidator()
+	require.NoError(t, err)
+
+	tests := []struct {
+		name     string
+		password string
+		tag      string
+		wantErr  bool
+	}{
+		{"no pattern", "Tr0ub4dor&3", "password_no_keyboard_walk", false},
+		{"qwerty passes password_strength but is rejected", "Qwerty#1", "password_no_keyboard_walk", true},
+		{"home row", "asdfgh", "password_no_keyboard_walk", true},
+		{"reversed row", "Xy#1poiu", "password_no_keyboard_walk", true},
+		{"column walks", "1qaz2wsx", "password_no_keyboard_walk", true},
+		{"reversed column", "zaq1", "password_no_keyboard_walk", true},
+		{"opposite diagonal", "4esz", "password_no_keyboard_walk", true},
+		{"shifted keys", "!QAZ@wsx", "password_no_keyboard_walk", true},
+		{"number row", "7890", "password_no_keyboard_walk", true},
+		{"three keys allowed", "qweXasd1", "password_no_keyboard_walk", false},
+		{"adjacent keys changing direction", "were", "password_no_keyboard_walk", false},
+		{"custom length allows longer patterns", "Qwerty#1", "password_no_keyboard_walk=6", false},
+		{"custom length rejects", "Xqw1", "password_no_keyboard_walk=1", true},
+		{"empty password", "", "password_no_keyboard_walk", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := v.Var(tt.password, tt.tag)
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+
+	t.Run("invalid parameter is a config error", func(t *testing.T) {
+		err := v.Var("Xy#1!", "password_no_keyboard_walk=0")
+		var configErr *ConfigError
+		require.ErrorAs(t, err, &configErr)
+		assert.Equal(t, "password_no_keyboard_walk", configErr.Tag)
+	})
+}
+
+func TestPasswordPolicy_MaxKeyboardWalkLength(t *testing.T) {
+	policy := DefaultPasswordPolicy()
+	policy.MaxKeyboardWalkLength = 3
+
+	assert.NoError(t, policy.Validate("Tr0ub4dor&3"))
+	require.NoError(t, DefaultPasswordPolicy().Validate("1qaz@WSX"))
+	err := policy.Validate("1qaz@WSX")
+	require.Error(t, err)
+	assert.Equal(t, "password must not contain keyboard patterns of more than 3 keys", err.Error())
+}
+
+func TestPasswordPolicy_Unicode(t *testing.T) {
+	t.Run("length counts characters, not bytes", func(t *testing.T) {
+		policy := PasswordPolicy{MinLength: 8, MaxLength: 10}
+
+		// 6 Thai characters are 18 bytes
+		assert.Error(t, policy.Validate("รหัสผ่าน"[:18]))
+		assert.NoError(t, policy.Validate("รหัสผ่าน"))
+		assert.Error(t, policy.Validate("รหัสผ่านยาวเกิน"))
+		assert.NoError(t, DefaultPasswordPolicy().Validate("Aa1!"+strings.Repeat("ก", 96)))
+	})
+
+	tests := []struct {
+		name     string
+		password string
+		ascii    bool
+		unicode  bool
+	}{
+		{"ascii password", "Passw0rd!", true, true},
+		{"thai letters satisfy case requirements", "รหัสผ่าน1!", false, true},
+		{"accented uppercase", "ÉCOLEé1!", false, true},
+		{"thai digit", "Passwordธ๑!", false, true},
+		{"unicode symbol as special", "Passw0rd€", false, true},
+		{"unicode punctuation as special", "Passw0rd¿", false, true},
+		{"still requires a digit", "รหัสผ่าน!", false, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			unicodePolicy := DefaultPasswordPolicy()
+			unicodePolicy.UnicodeClasses = true
+
+			assert.Equal(t, tt.ascii, DefaultPasswordPolicy().Validate(tt.password) == nil)
+			assert.Equal(t, tt.unicode, unicodePolicy.Validate(tt.password) == nil)
+		})
+	}
+
+	t.Run("description omits ASCII ranges", func(t *testing.T) {
+		policy := DefaultPasswordPolicy()
+		policy.UnicodeClasses = true
+		assert.Equal(t, "must contain at least 8 characters with: uppercase letter, lowercase letter, digit, and special character", policy.describe())
+	})
+}
+
+func TestPasswordPolicy_PassphraseLength(t *testing.T) {
+	policy := DefaultPasswordPolicy()
+	policy.PassphraseLength = 20
+	policy.MaxSequenceLength = 3
+
+	tests := []struct {
+		name     string
+		password string
+		errMsg   string
+	}{
+		{"complex password", "Passw0rd!", ""},
+		{"short password still needs classes", "correct horse", "must contain at least one"},
+		{"passphrase skips classes", "correct horse battery staple", ""},
+		{"passphrase length counts characters", "ม้าถูกต้องแบตเตอรี่ลวดเย็บกระดาษ", ""},
+		{"exactly passphrase length", "correct horse batter", ""},
+		{"one character short", "correct horse batte", "must contain at least one"},
+		{"passphrase still checks sequences", "aaaaaaaaaaaaaaaaaaaaaaaa", "repeated or sequential"},
+		{"passphrase still checks max length", strings.Repeat("horse ", 20), "must not exceed 100 characters"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := policy.Validate(tt.password)
+			if tt.errMsg == "" {
+				assert.NoError(t, err)
+				return
+			}
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.errMsg)
+		})
+	}
+
+	t.Run("description mentions passphrases", func(t *testing.T) {
+		assert.Contains(t, policy.describe(), ", or at least 20 characters as a passphrase")
+		assert.NotContains(t, DefaultPasswordPolicy().describe(), "passphrase")
+	})
+
+	t.Run("named policy", func(t *testing.T) {
+		v, err := NewValidator(WithPasswordPolicy("nist", PasswordPolicy{
+			MinLength:        8,
+			MaxLength:        64,
+			RequireUpper:     true,
+			RequireLower:     true,
+			RequireDigit:     true,
+			RequireSpecial:   true,
+			PassphraseLength: 20,
+		}))
+		require.NoError(t, err)
+
+		assert.NoError(t, v.Var("correct horse battery staple", "password_strength=nist"))
+		assert.Error(t, v.Var("correct horse battery staple", "password_strength"))
+	})
+}
+
+func TestPIN(t *testing.T) {
+	v, err := NewValidator(WithClock(func() time.Time {
+		return time.Date(2026, time.October, 16, 0, 0, 0, 0, time.UTC)
+	}))
+	require.NoError(t, err)
+
+	tests := []struct {
+		name    string
+		pin     string
+		tag     string
+		wantErr bool
+	}{
+		{"valid 4 digits", "2580", "pin=4:6", false},
+		{"valid 6 digits", "739164", "pin=4:6", false},
+		{"default range", "73916", "pin", false},
+		{"too short", "258", "pin=4:6", true},
+		{"too long", "7391648", "pin=4:6", true},
+		{"exact length", "739164", "pin=6", false},
+		{"exact length rejects shorter", "7391", "pin=6", true},
+		{"non-digit", "12a4", "pin=4:6", true},
+		{"full-width digits", "２５８０", "pin=4:6", true},
+		{"repeated digits", "0000", "pin=4:6", true},
+		{"repeated digits 6", "777777", "pin=4:6", true},
+		{"ascending", "1234", "pin=4:6", true},
+		{"descending", "654321", "pin=4:6", true},
+		{"ascending with wraparound", "7890", "pin=4:6", true},
+		{"descending with wraparound", "1098", "pin=4:6", true},
+		{"year allowed by default", "1990", "pin=4:6", false},
+		{"birth year rejected with noyear", "1990", "pin=4:6 noyear", true},
+		{"current year rejected with noyear", "2026", "pin=4 noyear", true},
+		{"future year allowed", "2031", "pin=4 noyear", false},
+		{"noyear only applies to 4 digits", "199005", "pin=4:6 noyear", false},
+		{"noyear with default range", "1985", "pin=noyear", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := v.Var(tt.pin, tt.tag)
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+
+	t.Run("invalid parameter is a config error", func(t *testing.T) {
+		for _, tag := range []string{"pin=6:4", "pin=x", "pin=4 6", "pin=0", "pin=1", "pin=3", "pin=2:6"} {
+			err := v.Var("2580", tag)
+			var configErr *ConfigError
+			require.ErrorAs(t, err, &configErr, tag)
+			assert.Equal(t, "pin", configErr.Tag)
+		}
+	})
+}
+
+func TestOTP(t *testing.T) {
+	v, err := NewValidator()
+	require.NoError(t, err)
+
+	tests := []struct {
+		name    string
+		code    string
+		tag     string
+		wantErr bool
+	}{
+		{"valid 6 digits", "493817", "otp=6", false},
+		{"default length", "493817", "otp", false},
+		{"leading zeros", "000123", "otp=6", false},
+		{"valid 8 digits", "49381702", "otp=8", false},
+		{"too short", "49381", "otp=6", true},
+		{"too long", "4938170", "otp=6", true},
+		{"inner space", "493 817", "otp=6", true},
+		{"surrounding whitespace", " 493817", "otp=6", true},
+		{"trailing newline", "493817\n", "otp=6", true},
+		{"hyphen", "493-817", "otp=7", true},
+		{"letters", "49381a", "otp=6", true},
+		{"thai digits", "๔๙๓๘๑๗", "otp=6", true},
+		{"empty", "", "otp=6", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := v.Var(tt.code, tt.tag)
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+
+	t.Run("invalid parameter is a config error", func(t *testing.T) {
+		err := v.Var("493817", "otp=six")
+		var configErr *ConfigError
+		require.ErrorAs(t, err, &configErr)
+		assert.Equal(t, "otp", configErr.Tag)
+	})
+}
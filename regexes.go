@@ -2,6 +2,7 @@ package xvalidator
 
 import (
 	"regexp"
+	"regexp/syntax"
 	"sync"
 )
 
@@ -9,6 +10,22 @@ import (
 const (
 	// e164RegexString matches E.164 phone numbers (international format).
 	e164RegexString = "^\\+[1-9]?[0-9]{7,14}$"
+
+	// thaiPlateRegexString matches Thai vehicle license plates: a series of one or two Thai consonants,
+	// optionally preceded by a digit when two consonants are used, then a 1-4 digit number without
+	// leading zero and an optional province name. The obsolete consonants ฃ and ฅ and the vowel letters
+	// ฤ and ฦ, which sit inside the consonant block (U+0E24, U+0E26), are excluded.
+	thaiPlateRegexString = "^(?:[1-9]" + thaiPlateConsonants + "{2}|" + thaiPlateConsonants + "{1,2})[ -]?[1-9][0-9]{0,3}(?: (.+))?$"
+
+	// thaiPlateConsonants is the class of Thai consonants used in plate series: ก-ฮ without ฃ, ฅ, ฤ and ฦ.
+	thaiPlateConsonants = "[กขคฆ-รลว-ฮ]"
+
+	// rfc3339RegexString matches the RFC 3339 date-time format with an uppercase T separator, optional
+	// fractional seconds and a Z or numeric offset. Field ranges are checked separately.
+	rfc3339RegexString = "^[0-9]{4}-[0-9]{2}-[0-9]{2}T[0-9]{2}:[0-9]{2}:[0-9]{2}(?:\\.[0-9]+)?(?:Z|[+-]([0-9]{2}):([0-9]{2}))$"
+
+	// slugRegexString matches lowercase URL slugs: ASCII letters and digits in words joined by single hyphens.
+	slugRegexString = "^[a-z0-9]+(?:-[a-z0-9]+)*$"
 )
 
 // lazyRegexCompile returns a function that compiles a regex pattern only once using sync.Once.
@@ -24,8 +41,35 @@ func lazyRegexCompile(pattern string) func() *regexp.Regexp {
 	}
 }
 
+// tagRegexCache holds a lazyRegexCompile function per pattern written in a regex rule parameter.
+// Unlike the patterns below, these are only known once a tag is validated, so they are keyed by pattern.
+var tagRegexCache sync.Map // pattern -> func() *regexp.Regexp
+
+// compileTagRegex compiles a pattern written in a validation tag, compiling each pattern only once.
+func compileTagRegex(pattern string) (*regexp.Regexp, error) {
+	if compile, ok := tagRegexCache.Load(pattern); ok {
+		return compile.(func() *regexp.Regexp)(), nil
+	}
+
+	// Parse reports every error regexp.Compile can return, so lazyRegexCompile cannot panic below.
+	if _, err := syntax.Parse(pattern, syntax.Perl); err != nil {
+		return nil, err
+	}
+	compile, _ := tagRegexCache.LoadOrStore(pattern, lazyRegexCompile(pattern))
+	return compile.(func() *regexp.Regexp)(), nil
+}
+
 // Pre-compiled regex functions
 var (
 	// E164Regex returns a compiled regex for validating E.164 phone numbers.
 	E164Regex = lazyRegexCompile(e164RegexString)
+
+	// ThaiPlateRegex returns a compiled regex for validating Thai vehicle license plates.
+	ThaiPlateRegex = lazyRegexCompile(thaiPlateRegexString)
+
+	// RFC3339Regex returns a compiled regex for validating RFC 3339 timestamps.
+	RFC3339Regex = lazyRegexCompile(rfc3339RegexString)
+
+	// SlugRegex returns a compiled regex for validating lowercase URL slugs.
+	SlugRegex = lazyRegexCompile(slugRegexString)
 )
@@ -16,9 +16,42 @@ func RegisterDecimalValidators(v *validator.Validate) {
 	v.RegisterValidation("deq", validateDecimalOperation(decimalEqual))
 	v.RegisterValidation("dneq", validateDecimalOperation(decimalNotEqual))
 
+	// Register decimal sign shortcuts
+	v.RegisterValidation("dpos", validateDecimalSign(decimalPositive))
+	v.RegisterValidation("dneg", validateDecimalSign(decimalNegative))
+	v.RegisterValidation("dnonzero", validateDecimalSign(decimalNonZero))
+
+	// Register cross-field decimal comparison operations
+	v.RegisterValidation("dgtfield", validateDecimalFieldOperation(decimalGreaterThan))
+	v.RegisterValidation("dgtefield", validateDecimalFieldOperation(decimalGreaterThanOrEqual))
+	v.RegisterValidation("dltfield", validateDecimalFieldOperation(decimalLessThan))
+	v.RegisterValidation("dltefield", validateDecimalFieldOperation(decimalLessThanOrEqual))
+	v.RegisterValidation("deqfield", validateDecimalFieldOperation(decimalEqual))
+
+	// Register decimal range validation
+	v.RegisterValidation("dbetween", validateDecimalBetween)
+
+	// Register decimal sum reconciliation
+	v.RegisterValidation("dsum", validateDecimalSum)
+
+	// Register decimal step validation
+	v.RegisterValidation("dmultipleof", validateDecimalMultipleOf)
+
+	// Register significant digits validation
+	v.RegisterValidation("dsigits", validateDecimalSignificantDigits)
+
 	// Register decimal precision and scale validation
 	v.RegisterValidation("decimal", validateDecimal)
 
+	// Register separator-tolerant decimal validation
+	v.RegisterValidation("decimal_loose", validateDecimalLoose)
+
+	// Register canonical decimal form validation
+	v.RegisterValidation("decimal_canonical", validateDecimalCanonical)
+
+	// Register currency-aware decimal scale validation
+	v.RegisterValidation("decimal_currency", validateDecimalCurrency)
+
 	// Register conditional decimal validation
 	v.RegisterValidation("decimal_if", validateDecimalIf)
 
@@ -26,20 +59,282 @@ func RegisterDecimalValidators(v *validator.Validate) {
 	v.RegisterCustomTypeFunc(decimalTypeFunc, decimal.Decimal{})
 }
 
+// RegisterConditionalValidators registers conditional meta-validation rules.
+// This function adds the when rule, which applies any registered rule only when sibling fields match,
+// and the required_if_d* rules, which require a field based on a sibling decimal comparison.
+func RegisterConditionalValidators(v *validator.Validate) {
+	// Register when to run even for nil fields, so unmet conditions do not fail nil pointers
+	v.RegisterValidationCtx("when", validateWhen(v), true)
+
+	// Register decimal-conditioned required rules (run even when the field is nil)
+	v.RegisterValidation("required_if_dgt", validateRequiredIfDecimal(decimalGreaterThan), true)
+	v.RegisterValidation("required_if_dgte", validateRequiredIfDecimal(decimalGreaterThanOrEqual), true)
+	v.RegisterValidation("required_if_dlt", validateRequiredIfDecimal(decimalLessThan), true)
+	v.RegisterValidation("required_if_dlte", validateRequiredIfDecimal(decimalLessThanOrEqual), true)
+}
+
+// RegisterPaymentValidators registers payment-related validation rules.
+// This function adds validators for currency codes, payment cards, bank routing numbers, VAT numbers
+// and cryptocurrency addresses.
+// Time-dependent rules use the system clock; use NewValidator with WithClock to change it.
+func RegisterPaymentValidators(v *validator.Validate) {
+	registerPaymentValidators(v, defaultOptions())
+}
+
+// registerPaymentValidators registers payment-related validation rules using the given configuration.
+func registerPaymentValidators(v *validator.Validate, o options) {
+	v.RegisterValidation("iso4217", validateISO4217)
+	v.RegisterValidation("card_number", validateCardNumber)
+	v.RegisterValidation("card_expiry", validateCardExpiry(o.now))
+	v.RegisterValidation("cvv", validateCVV)
+	v.RegisterValidation("aba_routing", validateABARouting)
+	v.RegisterValidation("vat", validateVAT)
+	v.RegisterValidation("btc_address", validateBTCAddress)
+	v.RegisterValidation("eth_address", validateETHAddress)
+}
+
+// RegisterLocaleValidators registers locale-related validation rules.
+// This function adds validators for ISO 3166-1 country codes, BCP 47 language tags and locale identifiers.
+func RegisterLocaleValidators(v *validator.Validate) {
+	v.RegisterValidation("country_code", validateCountryCode)
+	v.RegisterValidation("bcp47", validateBCP47)
+	v.RegisterValidation("locale", validateLocale)
+
+	// go-playground/validator ships country_code as an alias accepting alpha-2, alpha-3 and numeric codes.
+	// Aliases are resolved before validations for the bare tag, so point it at the alpha-2 form of our rule.
+	v.RegisterAlias("country_code", "country_code=alpha2")
+}
+
+// RegisterThaiValidators registers Thailand-specific validation rules.
+// This function adds validators for Thai national ID and taxpayer identification numbers,
+// bank codes, bank account numbers, vehicle license plates, Thai-script text and Buddhist Era dates.
+// Time-dependent rules use the system clock; use NewValidator with WithClock to change it.
+func RegisterThaiValidators(v *validator.Validate) {
+	registerThaiValidators(v, defaultOptions())
+}
+
+// registerThaiValidators registers Thailand-specific validation rules using the given configuration.
+func registerThaiValidators(v *validator.Validate, o options) {
+	v.RegisterValidation("thai_id", validateThaiID)
+	v.RegisterValidation("thai_tax_id", validateThaiTaxID)
+	v.RegisterValidation("thai_bank_code", validateThaiBankCode)
+	v.RegisterValidation("thai_bank_account", validateThaiBankAccount)
+	v.RegisterValidation("thai_plate", validateThaiPlate)
+
+	// Register Thai-script text validation (thai_text allows spaces, thai_text_only does not by default)
+	v.RegisterValidation("thai_text", validateThaiTextRule(true))
+	v.RegisterValidation("thai_text_only", validateThaiTextRule(false))
+
+	// Register Buddhist Era date validation
+	v.RegisterValidation("date_be", validateDateBE(o.now))
+}
+
 // RegisterURLValidators registers URL-specific validation rules.
-// This function adds validators for URL format and protocol validation.
+// This function adds validators for URL format, protocol, domain and host validation.
+// Host lookups use net.DefaultResolver; use NewValidator with WithResolver to change it.
+// url_reachable is not registered; it is only available through NewValidator with WithURLReachability.
 func RegisterURLValidators(v *validator.Validate) {
+	registerURLValidators(v, defaultOptions())
+}
+
+// registerURLValidators registers URL-specific validation rules using the given configuration.
+func registerURLValidators(v *validator.Validate, o options) {
 	v.RegisterValidation("https_url", validateHttpsScheme)
+	v.RegisterValidation("url_scheme", validateURLScheme)
+	v.RegisterValidation("url_domain", validateURLDomainRule(false))
+	v.RegisterValidation("url_domain_deny", validateURLDomainRule(true))
+	v.RegisterValidationCtx("url_public", validateURLPublic(o.resolver))
+	if o.reachability != nil || o.registerDisabled {
+		v.RegisterValidationCtx("url_reachable", validateURLReachable(o.reachability))
+	}
+	v.RegisterValidation("datauri", validateDataURI)
+	v.RegisterValidation("s3_uri", validateS3URI)
+	v.RegisterValidation("gcs_uri", validateGCSURI)
+	v.RegisterValidation("uri_template", validateURITemplate)
+	v.RegisterValidation("url_max", validateURLMax)
+	v.RegisterValidation("url_normalized", validateURLNormalized)
+	v.RegisterValidation("fqdn_public", validateFQDNPublic)
 }
 
 // RegisterPhoneValidators registers phone number validation rules using libphonenumber.
-// This function adds validators for international phone number format and type validation.
+// This function adds validators for international phone number format and type validation,
+// and for Thai numbers in local format.
 func RegisterPhoneValidators(v *validator.Validate) {
 	v.RegisterValidation("mobile_e164", validateMobileE164)
+	v.RegisterValidation("mobile_e164_possible", validateMobileE164Possible)
+	v.RegisterValidation("landline_e164", validateLandlineE164)
+	v.RegisterValidation("phone_e164", validatePhoneE164)
+	v.RegisterValidation("phone_no_premium", validatePhoneNoPremium)
+	v.RegisterValidation("phone_region_field", validatePhoneRegionField)
+	v.RegisterValidation("thai_phone", validateThaiPhone)
 }
 
 // RegisterPasswordValidators registers password validation rules.
-// This function adds validators for password strength and complexity requirements.
+// This function adds validators for password strength and complexity requirements, and for numeric PIN and
+// one-time password codes.
+// Only the built-in password policies are available; use NewValidator with WithPasswordPolicy to add more.
+// password_not_breached and password_not_reused are not registered; they are only available through
+// NewValidator with WithBreachCheck and WithPasswordHistory.
 func RegisterPasswordValidators(v *validator.Validate) {
-	v.RegisterValidation("password_strength", validatePasswordStrength)
+	registerPasswordValidators(v, defaultOptions())
+}
+
+// registerPasswordValidators registers password validation rules using the given configuration.
+func registerPasswordValidators(v *validator.Validate, o options) {
+	v.RegisterValidation("password_strength", validatePasswordStrength(o.passwordPolicies))
+	v.RegisterValidation("password_not_common", validatePasswordNotCommon(o.commonPasswords))
+	v.RegisterValidation("password_not_contains", validatePasswordNotContains)
+	v.RegisterValidation("password_no_sequences", validatePasswordNoSequences)
+	v.RegisterValidation("password_no_keyboard_walk", validatePasswordNoKeyboardWalk)
+	if o.breachCheck != nil || o.registerDisabled {
+		v.RegisterValidationCtx("password_not_breached", validatePasswordNotBreached(o.breachCheck))
+	}
+	if o.passwordHistory != nil || o.registerDisabled {
+		v.RegisterValidationCtx("password_not_reused", validatePasswordNotReused(o.passwordHistory))
+	}
+	v.RegisterValidation("pin", validatePIN(o.now))
+	v.RegisterValidation("otp", validateOTP)
+}
+
+// RegisterDateValidators registers date and time validation rules.
+// This function adds validators for dates relative to the current time, minimum ages from dates of birth,
+// cross-field date comparisons, time-of-day windows and RFC 3339 timestamps.
+// Time-dependent rules use the system clock; use NewValidator with WithClock to change it.
+func RegisterDateValidators(v *validator.Validate) {
+	registerDateValidators(v, defaultOptions())
+}
+
+// registerDateValidators registers date and time validation rules using the given configuration.
+func registerDateValidators(v *validator.Validate, o options) {
+	v.RegisterValidation("future_date", validateFutureDate(o.now))
+	v.RegisterValidation("past_date", validatePastDate(o.now))
+	v.RegisterValidation("age_gte", validateAgeGTE(o.now))
+
+	// Register cross-field date comparison operations
+	v.RegisterValidation("dtgtfield", validateDateFieldOperation(dateAfter))
+	v.RegisterValidation("dtgtefield", validateDateFieldOperation(dateAfterOrEqual))
+	v.RegisterValidation("dtltfield", validateDateFieldOperation(dateBefore))
+	v.RegisterValidation("dtltefield", validateDateFieldOperation(dateBeforeOrEqual))
+
+	// Register time-of-day window validation
+	v.RegisterValidation("time_between", validateTimeBetween)
+
+	// Register strict RFC 3339 timestamp validation
+	v.RegisterValidation("rfc3339", validateRFC3339Rule(false))
+	v.RegisterValidation("rfc3339_utc", validateRFC3339Rule(true))
+}
+
+// RegisterEmailValidators registers email validation rules.
+// This function adds validators for email domain checks, such as rejecting disposable or free email providers.
+// email_mx is not registered; it is only available through NewValidator with WithEmailMX.
+func RegisterEmailValidators(v *validator.Validate) {
+	registerEmailValidators(v, defaultOptions())
+}
+
+// registerEmailValidators registers email validation rules using the given configuration.
+func registerEmailValidators(v *validator.Validate, o options) {
+	if o.emailMX != nil || o.registerDisabled {
+		v.RegisterValidationCtx("email_mx", validateEmailMX(o.emailMX))
+	}
+	v.RegisterValidation("email_not_disposable", validateEmailDomainNotIn(o.disposableEmailDomains))
+	v.RegisterValidation("email_business", validateEmailDomainNotIn(o.freeEmailDomains))
+	v.RegisterValidation("email_idn", validateEmailIDN)
+}
+
+// RegisterIdentifierValidators registers identifier validation rules.
+// This function adds validators for URL slugs, semantic versions, NanoIDs and sortable IDs (ULID, KSUID, Snowflake).
+func RegisterIdentifierValidators(v *validator.Validate) {
+	registerIdentifierValidators(v, defaultOptions())
+}
+
+// registerIdentifierValidators registers identifier validation rules using the given configuration.
+func registerIdentifierValidators(v *validator.Validate, o options) {
+	v.RegisterValidation("slug", validateSlug)
+	v.RegisterValidation("semver", validateSemver)
+	v.RegisterValidation("nanoid", validateNanoID)
+
+	// Sortable IDs with embedded timestamps
+	v.RegisterValidation("ulid", validateULID(o.now))
+	v.RegisterValidation("ksuid", validateKSUID(o.now))
+	v.RegisterValidation("snowflake", validateSnowflake(o.now))
+}
+
+// RegisterContentValidators registers validation rules for encoded or structured string content.
+// This function adds validators for base64url data, JSON or YAML text and regular expressions.
+// Named regex patterns are only available through NewValidator with Validator.RegisterPattern.
+func RegisterContentValidators(v *validator.Validate) {
+	registerContentValidators(v, defaultOptions())
+}
+
+// registerContentValidators registers content validation rules using the given configuration.
+func registerContentValidators(v *validator.Validate, o options) {
+	v.RegisterValidation("base64url", validateBase64URL)
+	v.RegisterValidation("json_string", validateJSONString)
+	v.RegisterValidation("yaml_string", validateYAMLString)
+	v.RegisterValidation("regex", validateRegex(o.patterns))
+}
+
+// RegisterTextValidators registers validation rules for free-form text.
+// This function adds validators for denied characters, emoji, banned words and HTML markup.
+func RegisterTextValidators(v *validator.Validate) {
+	registerTextValidators(v, defaultOptions())
+}
+
+// registerTextValidators registers text validation rules using the given configuration.
+func registerTextValidators(v *validator.Validate, o options) {
+	v.RegisterValidation("excludes_chars", validateExcludesChars)
+	v.RegisterValidation("no_emoji", validateNoEmoji)
+	v.RegisterValidation("clean_text", validateCleanText(o.wordList))
+	v.RegisterValidation("no_html", validateNoHTML)
+}
+
+// RegisterUploadValidators registers validation rules for file upload metadata.
+// This function adds validators for file names and MIME types.
+func RegisterUploadValidators(v *validator.Validate) {
+	v.RegisterValidation("filename", validateFilename)
+	v.RegisterValidation("mimetype", validateMIMEType)
+}
+
+// RegisterGeoValidators registers geographic validation rules.
+// This function adds validators for latitude and longitude decimal strings and GeoJSON documents.
+func RegisterGeoValidators(v *validator.Validate) {
+	v.RegisterValidation("latitude_str", validateCoordinate(90))
+	v.RegisterValidation("longitude_str", validateCoordinate(180))
+	v.RegisterValidation("geojson", validateGeoJSON)
+}
+
+// RegisterNetworkValidators registers network validation rules.
+// This function adds validators for IP address allowlists, host:port endpoints and port numbers.
+func RegisterNetworkValidators(v *validator.Validate) {
+	v.RegisterValidation("ip_in", validateIPIn)
+	v.RegisterValidation("hostport", validateHostPort)
+	v.RegisterValidation("port", validatePort)
+	v.RegisterValidation("port_range", validatePortRange)
+}
+
+// RegisterProductValidators registers product, publication and vehicle code validation rules.
+// This function adds validators for ISBN-10, ISBN-13, EAN-13, UPC-A and GTIN numbers, replacing
+// go-playground/validator's isbn, isbn10 and isbn13 rules, and for vehicle identification numbers.
+func RegisterProductValidators(v *validator.Validate) {
+	v.RegisterValidation("isbn", validateISBN)
+	v.RegisterValidation("isbn10", validateISBN10)
+	v.RegisterValidation("isbn13", validateISBN13)
+	v.RegisterValidation("ean13", validateGS1Digits(13))
+	v.RegisterValidation("upc", validateGS1Digits(12))
+	v.RegisterValidation("gtin", validateGTIN)
+	v.RegisterValidation("vin", validateVIN)
+}
+
+// RegisterDocumentValidators registers identity document validation rules.
+// This function adds validators for passport machine readable zones and passport numbers.
+// Only the built-in passport number formats are available; use NewValidator with
+// Validator.RegisterPassportFormat to add more.
+func RegisterDocumentValidators(v *validator.Validate) {
+	registerDocumentValidators(v, defaultOptions())
+}
+
+// registerDocumentValidators registers identity document validation rules using the given configuration.
+func registerDocumentValidators(v *validator.Validate, o options) {
+	v.RegisterValidation("mrz", validateMRZ)
+	v.RegisterValidation("passport", validatePassport(o.passports))
 }
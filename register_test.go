@@ -6,6 +6,7 @@ import (
 	"github.com/go-playground/validator/v10"
 	"github.com/shopspring/decimal"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestRegisterDecimalValidators(t *testing.T) {
@@ -167,3 +168,53 @@ func TestRegisterValidators_Integration(t *testing.T) {
 	err = v.Struct(invalidData)
 	assert.Error(t, err)
 }
+
+func TestRecoverConfigError(t *testing.T) {
+	v := validator.New()
+	RegisterDecimalValidators(v)
+
+	type Payment struct {
+		Amount string `validate:"decimal_if=2@Missing=card"`
+	}
+
+	validate := func(s any) (err error) {
+		defer RecoverConfigError(&err)
+		return v.Struct(s)
+	}
+
+	var configErr *ConfigError
+	err := validate(Payment{Amount: "10.00"})
+	require.ErrorAs(t, err, &configErr)
+	assert.Equal(t, "decimal_if", configErr.Tag)
+
+	assert.PanicsWithValue(t, "boom", func() {
+		var err error
+		defer RecoverConfigError(&err)
+		panic("boom")
+	})
+}
+
+func TestRegisterValidators_OptInRulesNotRegistered(t *testing.T) {
+	v := validator.New()
+	RegisterURLValidators(v)
+	RegisterPasswordValidators(v)
+	RegisterEmailValidators(v)
+
+	tests := []struct {
+		value string
+		tag   string
+	}{
+		{"https://example.com", "url_reachable"},
+		{"hunter2", "password_not_breached"},
+		{"hunter2", "password_not_reused"},
+		{"user@example.com", "email_mx"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.tag, func(t *testing.T) {
+			assert.PanicsWithValue(t, "Undefined validation function '"+tt.tag+"' on field ''", func() {
+				_ = v.Var(tt.value, tt.tag)
+			})
+		})
+	}
+}
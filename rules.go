@@ -1,10 +1,13 @@
 package xvalidator
 
 import (
+	"math"
 	"net/url"
 	"reflect"
 	"strconv"
 	"strings"
+	"sync"
+	"time"
 
 	"github.com/go-playground/validator/v10"
 	"github.com/nyaruka/phonenumbers"
@@ -23,21 +26,15 @@ const (
 // Decimal validation logic functions
 
 // validateDecimalOperation creates a validator function for decimal operations.
-// It handles string input and compares it using the provided comparator function.
+// It handles string, numeric, and decimal.Decimal input and compares it using the provided comparator function.
 func validateDecimalOperation(comparator func(d1, d2 *decimal.Decimal) bool) validator.Func {
 	return func(fl validator.FieldLevel) bool {
-		// Handle string input for decimal validation
-		data, ok := fl.Field().Interface().(string)
+		// Convert string, numeric, or decimal.Decimal input to a decimal value
+		value, ok := decimalFieldValue(fl.Field())
 		if !ok {
 			return false
 		}
 
-		// Parse field value as decimal
-		value, err := decimal.NewFromString(data)
-		if err != nil {
-			return false
-		}
-
 		// Parse parameter value as decimal
 		baseValue, err := decimal.NewFromString(fl.Param())
 		if err != nil {
@@ -48,6 +45,53 @@ func validateDecimalOperation(comparator func(d1, d2 *decimal.Decimal) bool) val
 	}
 }
 
+// decimalFieldString extracts the textual decimal value from a field.
+// Any string-kinded type is accepted, including json.Number produced by json.Decoder.UseNumber
+// and custom string types, so decoded payloads are validated instead of silently failing.
+func decimalFieldString(field reflect.Value) (string, bool) {
+	if field.Kind() != reflect.String {
+		return "", false
+	}
+	return field.String(), true
+}
+
+// decimalFieldValue converts a field into a decimal value for comparison rules.
+// Supported inputs are string kinds (including json.Number), signed and unsigned integers,
+// floats, and decimal.Decimal values or pointers, which are converted through decimalTypeFunc.
+func decimalFieldValue(field reflect.Value) (decimal.Decimal, bool) {
+	switch field.Kind() {
+	case reflect.String:
+		value, err := decimal.NewFromString(field.String())
+		if err != nil {
+			return decimal.Decimal{}, false
+		}
+		return value, true
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return decimal.NewFromInt(field.Int()), true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return decimal.NewFromUint64(field.Uint()), true
+	case reflect.Float32, reflect.Float64:
+		f := field.Float()
+		if math.IsNaN(f) || math.IsInf(f, 0) {
+			return decimal.Decimal{}, false
+		}
+		if field.Kind() == reflect.Float32 {
+			return decimal.NewFromFloat32(float32(f)), true
+		}
+		return decimal.NewFromFloat(f), true
+	case reflect.Ptr:
+		if field.IsNil() {
+			return decimal.Decimal{}, false
+		}
+		return decimalFieldValue(field.Elem())
+	case reflect.Struct:
+		if data, ok := decimalTypeFunc(field).(string); ok {
+			return decimalFieldValue(reflect.ValueOf(data))
+		}
+	}
+	return decimal.Decimal{}, false
+}
+
 // Decimal comparison functions
 
 // decimalGreaterThan compares if first decimal is greater than second.
@@ -90,56 +134,57 @@ func decimalNotEqual(a, b *decimal.Decimal) bool {
 //   - mobile_e164=TH: validates Thailand mobile numbers only
 //   - mobile_e164=US: validates US mobile numbers only
 //   - mobile_e164=XX: validates specific country mobile numbers
+//   - mobile_e164=!RU !KP: validates mobile numbers from any country except RU and KP
 func validateMobileE164(fl validator.FieldLevel) bool {
-	phoneNumber := fl.Field().String()
-
-	// First check E.164 format with regex for performance
-	if !E164Regex().MatchString(phoneNumber) {
-		return false
-	}
-
-	// Parse the phone number without specifying region (let the library determine from prefix)
-	num, err := phonenumbers.Parse(phoneNumber, "")
-	if err != nil {
-		return false
-	}
-
-	// Check if the number is valid
-	if !phonenumbers.IsValidNumber(num) {
+	regions := parsePhoneRegions(fl)
+	num, ok := parseE164Number(fl.Field().String())
+	if !ok {
 		return false
 	}
 
 	// Get the number type
-	numberType := phonenumbers.GetNumberType(num)
+	numberType := num.numberType
 
 	// Must be mobile type or fixed line or mobile (common in US and some countries)
 	if numberType != phonenumbers.MOBILE && numberType != phonenumbers.FIXED_LINE_OR_MOBILE {
 		return false
 	}
 
-	// Check country-specific validation if parameter is provided
-	param := fl.Param()
-	if param != "" {
-		// Get the region code from the parsed number
-		regionCode := phonenumbers.GetRegionCodeForNumber(num)
-
-		// Compare with the expected country code
-		if regionCode != param {
-			return false
-		}
-	}
-	return true
+	// Check country-specific allow/deny lists if a parameter is provided
+	return regions.allows(num.region)
 }
 
 // URL validation logic functions
 
 // validateHttpsScheme validates that the URL uses HTTPS scheme and has a valid host.
+// Space-separated options add restrictions:
+//   - https_url=nocreds: rejects embedded credentials (userinfo such as "user:pass@")
+//   - https_url=stdport: rejects explicit ports other than 443
+//   - https_url=nocreds stdport: both restrictions
 func validateHttpsScheme(fl validator.FieldLevel) bool {
+	var noCreds, stdPort bool
+	for _, option := range strings.Fields(fl.Param()) {
+		switch option {
+		case "nocreds":
+			noCreds = true
+		case "stdport":
+			stdPort = true
+		default:
+			panicConfigError(fl, "expected options 'nocreds' and/or 'stdport'")
+		}
+	}
+
 	urlStr := fl.Field().String()
 	parsed, err := url.Parse(urlStr)
 	if err != nil || parsed.Scheme != "https" || parsed.Host == "" {
 		return false
 	}
+	if noCreds && parsed.User != nil {
+		return false
+	}
+	if stdPort && parsed.Port() != "" && parsed.Port() != "443" {
+		return false
+	}
 	return true
 }
 
@@ -162,23 +207,17 @@ func decimalTypeFunc(field reflect.Value) any {
 //   - decimal=10:6 (precision=10, scale=6)
 func validateDecimal(fl validator.FieldLevel) bool {
 	// Handle string input for decimal validation
-	data, ok := fl.Field().Interface().(string)
+	data, ok := decimalFieldString(fl.Field())
 	if !ok {
 		return false
 	}
 
-	// Parse field value as decimal
-	value, err := decimal.NewFromString(data)
-	if err != nil {
-		return false
-	}
-
-	// Parse parameters for precision and scale
+	// Parse parameters for precision and scale (cached per tag parameter)
 	param := fl.Param()
-	precision, scale := parseDecimalParams(param)
+	precision, scale := cachedDecimalParams(param)
 
-	// Validate precision and scale
-	return validateDecimalPrecisionScale(value, precision, scale)
+	// Validate precision and scale without constructing a decimal for plain input
+	return validateDecimalString(data, precision, scale)
 }
 
 // parseDecimalParams parses decimal validation parameters.
@@ -213,6 +252,63 @@ func parseDecimalParams(param string) (precision, scale int32) {
 	return precision, scale
 }
 
+// Decimal tag parameter caches
+
+// decimalParams holds the parsed form of a decimal rule parameter.
+type decimalParams struct {
+	precision int32
+	scale     int32
+}
+
+// decimalIfParams holds the parsed form of a decimal_if rule parameter.
+type decimalIfParams struct {
+	precision  int32
+	scale      int32
+	conditions fieldConditions
+	err        error
+}
+
+var (
+	// decimalParamsCache caches parseDecimalParams results keyed by the raw tag parameter.
+	decimalParamsCache sync.Map
+
+	// decimalIfParamsCache caches parseDecimalIfParam results keyed by the raw tag parameter.
+	decimalIfParamsCache sync.Map
+)
+
+// cachedDecimalParams returns parseDecimalParams results, parsing each distinct parameter only once.
+// Parameters come from struct tags, so the number of cached entries stays bounded by the tags in use.
+func cachedDecimalParams(param string) (precision, scale int32) {
+	if cached, ok := decimalParamsCache.Load(param); ok {
+		params := cached.(decimalParams)
+		return params.precision, params.scale
+	}
+
+	precision, scale = parseDecimalParams(param)
+	decimalParamsCache.Store(param, decimalParams{precision: precision, scale: scale})
+	return precision, scale
+}
+
+// cachedDecimalIfParams returns the parsed decimal_if parameter, parsing each distinct parameter only once.
+// The conditions are shared between callers and must not be modified.
+func cachedDecimalIfParams(param string) decimalIfParams {
+	if cached, ok := decimalIfParamsCache.Load(param); ok {
+		return cached.(decimalIfParams)
+	}
+
+	var params decimalIfParams
+	rule, conditions, err := parseDecimalIfParam(param)
+	if err != nil {
+		params.err = err
+	} else {
+		params.precision, params.scale = parseDecimalParams(rule)
+		params.conditions = conditions
+	}
+
+	decimalIfParamsCache.Store(param, params)
+	return params
+}
+
 // validateDecimalPrecisionScale validates if decimal value fits within specified precision and scale.
 func validateDecimalPrecisionScale(value decimal.Decimal, precision, scale int32) bool {
 	// Get string representation of the decimal
@@ -242,6 +338,11 @@ func validateDecimalPrecisionScale(value decimal.Decimal, precision, scale int32
 	integerDigits := int32(len(integerPart))
 	decimalPlaces := int32(len(decimalPart))
 
+	return decimalDigitsFit(integerDigits, decimalPlaces, precision, scale)
+}
+
+// decimalDigitsFit checks integer digit and decimal place counts against precision and scale.
+func decimalDigitsFit(integerDigits, decimalPlaces, precision, scale int32) bool {
 	// Validate scale (decimal places)
 	if decimalPlaces > scale {
 		return false
@@ -254,93 +355,399 @@ func validateDecimalPrecisionScale(value decimal.Decimal, precision, scale int32
 	return integerDigits <= maxIntegerDigits
 }
 
+// scanDecimalDigits counts integer digits and decimal places of a plain decimal string without allocating.
+// It accepts only the plain form [+-]digits[.digits] and applies the same normalization as decimal.Decimal.String:
+// leading integer zeros and trailing fractional zeros are not counted, and a zero integer part counts as one digit.
+// Returns ok=false for any other input (exponents, missing digits, whitespace), which callers
+// must parse with decimal.NewFromString instead.
+func scanDecimalDigits(s string) (integerDigits, decimalPlaces int32, ok bool) {
+	i := 0
+	if i < len(s) && (s[i] == '-' || s[i] == '+') {
+		i++
+	}
+
+	// Integer part: skip leading zeros, then count remaining digits
+	start := i
+	for i < len(s) && s[i] == '0' {
+		i++
+	}
+	for i < len(s) && s[i] >= '0' && s[i] <= '9' {
+		integerDigits++
+		i++
+	}
+	if i == start {
+		return 0, 0, false
+	}
+	if integerDigits == 0 {
+		integerDigits = 1
+	}
+
+	if i == len(s) {
+		return integerDigits, 0, true
+	}
+	if s[i] != '.' {
+		return 0, 0, false
+	}
+	i++
+
+	// Fractional part: count up to the last non-zero digit
+	start = i
+	for ; i < len(s); i++ {
+		if s[i] < '0' || s[i] > '9' {
+			return 0, 0, false
+		}
+		if s[i] != '0' {
+			decimalPlaces = int32(i - start + 1)
+		}
+	}
+	if i == start {
+		return 0, 0, false
+	}
+
+	return integerDigits, decimalPlaces, true
+}
+
+// validateDecimalString validates that a decimal string fits within precision and scale.
+// Plain decimal strings are checked by scanning them directly; other accepted forms
+// such as exponent notation are parsed with decimal.NewFromString.
+func validateDecimalString(data string, precision, scale int32) bool {
+	if integerDigits, decimalPlaces, ok := scanDecimalDigits(data); ok {
+		return decimalDigitsFit(integerDigits, decimalPlaces, precision, scale)
+	}
+
+	value, err := decimal.NewFromString(data)
+	if err != nil {
+		return false
+	}
+
+	return validateDecimalPrecisionScale(value, precision, scale)
+}
+
 // parseDecimalIfParam parses the decimal_if parameter.
-// Parameter format: "rule@field=value"
+// Parameter format: "rule@conditions"
 // Examples:
-//   - "2@Mode=mode1" -> rule="2", field="Mode", expect="mode1"
-//   - "38:19@Mode=mode2" -> rule="38:19", field="Mode", expect="mode2"
+//   - "2@Mode=mode1" -> rule="2", conditions: Mode equals "mode1"
+//   - "38:19@Mode=mode2" -> rule="38:19", conditions: Mode equals "mode2"
+//   - "2@Type=credit&Region=TH" -> rule="2", conditions: Type equals "credit" and Region equals "TH"
+//   - "2@Type=credit|Type=debit" -> rule="2", conditions: Type equals "credit" or Type equals "debit"
 //
-// Returns rule (decimal format), field name, expected value, and error.
-func parseDecimalIfParam(param string) (rule, field, expect string, err error) {
+// Returns rule (decimal format), parsed conditions, and error.
+func parseDecimalIfParam(param string) (rule string, conditions fieldConditions, err error) {
 	// Split by @ to separate rule and condition
 	parts := strings.Split(param, "@")
 	if len(parts) != 2 {
-		return "", "", "", validator.ValidationErrors{}
+		return "", nil, validator.ValidationErrors{}
 	}
 
 	rule = parts[0]
 
-	// Split condition by = to get field and expected value
-	conditionParts := strings.Split(parts[1], "=")
-	if len(conditionParts) != 2 {
-		return "", "", "", validator.ValidationErrors{}
+	// Parse condition expression into field/value pairs
+	conditions, err = parseFieldConditions(parts[1])
+	if err != nil {
+		return "", nil, err
 	}
 
-	field = conditionParts[0]
-	expect = conditionParts[1]
-
-	return rule, field, expect, nil
+	return rule, conditions, nil
 }
 
 // validateDecimalIf validates decimal precision and scale conditionally based on another field's value.
-// Parameter format: "rule@field=value"
+// Parameter format: "rule@field=value", where field may be a dotted path such as Payment.Method.
+// The condition field may be a string, custom string type, bool, integer, float, or fmt.Stringer.
+// Several conditions can be combined with & (AND) and | (OR, written 0x7C inside struct tags).
 // Supports formats:
 //   - decimal_if=2@Mode=mode1 -> if Mode equals "mode1", validate with scale 2 (precision=DefaultPrecision)
 //   - decimal_if=38:19@Mode=mode2 -> if Mode equals "mode2", validate with precision 38 and scale 19
 //   - decimal_if=0@Mode=mode3 -> if Mode equals "mode3", validate with scale 0 (integer only)
 //   - decimal_if=@Mode=mode4 -> if Mode equals "mode4", use default precision and scale
+//   - decimal_if=2@Payment.Method=card -> if the nested Payment.Method equals "card", validate with scale 2
+//   - decimal_if=0@Mode=1 -> if the int field Mode equals 1, validate with scale 0
+//   - decimal_if=2@Type=credit&Region=TH -> if Type equals "credit" and Region equals "TH", validate with scale 2
+//   - decimal_if=2@Type=credit0x7CType=debit -> if Type equals "credit" or "debit", validate with scale 2
+//
+// Malformed parameters and references to nonexistent fields panic with *ConfigError.
 func validateDecimalIf(fl validator.FieldLevel) bool {
-	rule, field, expect, err := parseDecimalIfParam(fl.Param())
-	if err != nil {
-		return false
+	// Parse rule and conditions (cached per tag parameter)
+	params := cachedDecimalIfParams(fl.Param())
+	if params.err != nil {
+		panicConfigError(fl, "expected format rule@Field=value")
 	}
 
-	// Read other field value to check condition
-	parent := fl.Parent()
-	otherField := parent.FieldByName(field)
-	if !otherField.IsValid() {
-		return false
+	// Read other field values to check conditions (dotted paths and pointer parents are supported)
+	matched, found := params.conditions.match(fl.Parent())
+	if !found {
+		panicConfigError(fl, "condition references a field that does not exist")
 	}
-
-	other := otherField.String()
-	if other != expect {
+	if !matched {
 		return true // Condition not met → skip validation
 	}
 
 	// Handle string input for decimal validation (same as validateDecimal)
-	data, ok := fl.Field().Interface().(string)
+	data, ok := decimalFieldString(fl.Field())
 	if !ok {
 		return false
 	}
 
-	// Parse field value as decimal
-	value, err := decimal.NewFromString(data)
-	if err != nil {
-		return false
-	}
-
-	// Parse parameters for precision and scale using same logic as decimal rule
-	precision, scale := parseDecimalParams(rule)
-
 	// Validate precision and scale using same logic as decimal rule
-	return validateDecimalPrecisionScale(value, precision, scale)
+	return validateDecimalString(data, params.precision, params.scale)
 }
 
 // Password validation logic functions
 
-// validatePasswordStrength validates password strength according to security requirements.
-// Password must meet the following criteria:
+// validatePasswordStrength returns a validator checking passwords against a named PasswordPolicy.
+// Without a parameter, DefaultPasswordPolicy applies:
 //   - At least 8 characters long
 //   - Contains at least one uppercase letter (A-Z)
 //   - Contains at least one lowercase letter (a-z)
 //   - Contains at least one digit (0-9)
 //   - Contains at least one special character (!@#$%^&*()_+-=[]{}|;:,.<>?)
-func validatePasswordStrength(fl validator.FieldLevel) bool {
-	password := fl.Field().String()
+//
+// password_strength=strict uses StrictPasswordPolicy; other names are registered with WithPasswordPolicy.
+func validatePasswordStrength(policies map[string]PasswordPolicy) validator.Func {
+	return func(fl validator.FieldLevel) bool {
+		policy, ok := policies[fl.Param()]
+		if !ok {
+			panicConfigError(fl, "unknown password policy; register it with WithPasswordPolicy")
+		}
+
+		password := fl.Field().String()
+
+		if err := policy.Validate(password); err != nil {
+			return false
+		}
+
+		return true
+	}
+}
+
+// validatePasswordNotCommon returns a validator rejecting passwords found in a list of common passwords,
+// such as "Password123!", which passes the character-class checks of password_strength.
+// Case and common decorations (leading or trailing digits and symbols) are ignored.
+// The list is the embedded CommonPasswords unless replaced with WithCommonPasswords.
+func validatePasswordNotCommon(passwords func() passwordSet) validator.Func {
+	return func(fl validator.FieldLevel) bool {
+		return !passwords().contains(fl.Field().String())
+	}
+}
+
+// minPasswordNotContainsLength is the shortest referenced value checked by password_not_contains;
+// shorter values (e.g., a one-letter initial) would reject too many passwords.
+const minPasswordNotContainsLength = 3
+
+// validatePasswordNotContains validates that a password does not contain, case-insensitively,
+// the value of any of the referenced sibling fields. For email addresses the local part is also checked,
+// so "john.doe@example.com" rejects "John.Doe2026!". Empty values and values shorter than 3 characters are ignored.
+// Usage: `validate:"password_not_contains=Username Email"`
+func validatePasswordNotContains(fl validator.FieldLevel) bool {
+	fields := strings.Fields(fl.Param())
+	if len(fields) == 0 {
+		panicConfigError(fl, "expected at least one field name")
+	}
+
+	password := strings.ToLower(fl.Field().String())
+	for _, name := range fields {
+		field, found := lookupFieldPath(fl.Parent(), name)
+		if !found {
+			panicConfigError(fl, "password references a field that does not exist")
+		}
+		if !field.IsValid() || field.Kind() != reflect.String {
+			continue
+		}
+
+		value := strings.ToLower(strings.TrimSpace(field.String()))
+		candidates := []string{value}
+		if local, _, ok := strings.Cut(value, "@"); ok {
+			candidates = append(candidates, local)
+		}
+		for _, candidate := range candidates {
+			if len(candidate) >= minPasswordNotContainsLength && strings.Contains(password, candidate) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// defaultPasswordMaxSequence is the longest run of repeated or sequential characters allowed by
+// password_no_sequences without a parameter.
+const defaultPasswordMaxSequence = 3
+
+// validatePasswordNoSequences validates that a password has no run of repeated ("aaaa") or sequential
+// ("1234", "abcd", "dcba") characters longer than the parameter. Letters are compared case-insensitively.
+// Usage:
+//   - `validate:"password_no_sequences"` - runs of up to 3 characters
+//   - `validate:"password_no_sequences=2"` - runs of up to 2 characters
+func validatePasswordNoSequences(fl validator.FieldLevel) bool {
+	maxLength := defaultPasswordMaxSequence
+	if param := fl.Param(); param != "" {
+		var err error
+		maxLength, err = strconv.Atoi(param)
+		if err != nil || maxLength <= 0 {
+			panicConfigError(fl, "expected a positive maximum sequence length")
+		}
+	}
+
+	return longestPasswordSequence(fl.Field().String()) <= maxLength
+}
+
+// defaultPasswordMaxKeyboardWalk is the longest keyboard pattern allowed by password_no_keyboard_walk
+// without a parameter.
+const defaultPasswordMaxKeyboardWalk = 3
+
+// validatePasswordNoKeyboardWalk validates that a password has no straight keyboard pattern on a US QWERTY
+// layout ("qwerty", "asdfgh", "1qaz2wsx", "zaq1") longer than the parameter. Shifted keys count as their
+// unshifted key, so "QWER" and "!QAZ" are patterns too.
+// Usage:
+//   - `validate:"password_no_keyboard_walk"` - patterns of up to 3 keys
+//   - `validate:"password_no_keyboard_walk=4"` - patterns of up to 4 keys
+func validatePasswordNoKeyboardWalk(fl validator.FieldLevel) bool {
+	maxLength := defaultPasswordMaxKeyboardWalk
+	if param := fl.Param(); param != "" {
+		var err error
+		maxLength, err = strconv.Atoi(param)
+		if err != nil || maxLength <= 0 {
+			panicConfigError(fl, "expected a positive maximum pattern length")
+		}
+	}
 
-	if err := ValidatePasswordStrength(password); err != nil {
+	return longestKeyboardWalk(fl.Field().String()) <= maxLength
+}
+
+// PIN validation logic functions
+
+// defaultPINMinLength and defaultPINMaxLength bound PIN lengths when the pin rule has no length parameter.
+const (
+	defaultPINMinLength = 4
+	defaultPINMaxLength = 6
+)
+
+// minPINLength is the shortest length a pin parameter may allow. Shorter PINs are too easy to guess, and
+// single-digit ones are always rejected as repeated digits.
+const minPINLength = 4
+
+// pinParams holds the parsed form of a pin rule parameter.
+type pinParams struct {
+	minLength, maxLength int
+	noYear               bool
+}
+
+// parsePINParam parses a pin parameter: an optional length ("6") or length range ("4:6"),
+// optionally followed by "noyear".
+func parsePINParam(param string) (pinParams, bool) {
+	params := pinParams{minLength: defaultPINMinLength, maxLength: defaultPINMaxLength}
+
+	fields := strings.Fields(param)
+	if len(fields) > 0 && fields[len(fields)-1] == "noyear" {
+		params.noYear = true
+		fields = fields[:len(fields)-1]
+	}
+	switch len(fields) {
+	case 0:
+		return params, true
+	case 1:
+	default:
+		return pinParams{}, false
+	}
+
+	minText, maxText, isRange := strings.Cut(fields[0], ":")
+	if !isRange {
+		maxText = minText
+	}
+	minLength, minErr := strconv.Atoi(minText)
+	maxLength, maxErr := strconv.Atoi(maxText)
+	if minErr != nil || maxErr != nil || minLength < minPINLength || maxLength < minLength {
+		return pinParams{}, false
+	}
+	params.minLength, params.maxLength = minLength, maxLength
+	return params, true
+}
+
+// isTrivialPIN reports whether pin consists of a single repeated digit ("1111") or of digits ascending or
+// descending by one ("1234", "6543"), wrapping around between 9 and 0 ("7890").
+func isTrivialPIN(pin string) bool {
+	repeated, ascending, descending := true, true, true
+	for i := 1; i < len(pin); i++ {
+		step := (int(pin[i]) - int(pin[i-1]) + 10) % 10
+		repeated = repeated && step == 0
+		ascending = ascending && step == 1
+		descending = descending && step == 9
+	}
+	return repeated || ascending || descending
+}
+
+// validatePIN returns a validator for numeric PIN codes within a length range that rejects trivial PINs:
+// repeated digits ("0000") and ascending or descending sequences ("1234", "4321"). With "noyear",
+// 4-digit PINs that look like a year between 1900 and the current year according to now are rejected too.
+// Usage:
+//   - `validate:"pin"` - 4 to 6 digits
+//   - `validate:"pin=4:6"` - 4 to 6 digits
+//   - `validate:"pin=6"` - exactly 6 digits
+//   - `validate:"pin=4 noyear"` - 4 digits, rejecting birth-year-like values such as 1990
+func validatePIN(now func() time.Time) validator.Func {
+	return func(fl validator.FieldLevel) bool {
+		params, ok := parsePINParam(fl.Param())
+		if !ok {
+			panicConfigError(fl, "expected a length of at least 4 such as 6 or 4:6, optionally followed by noyear")
+		}
+
+		field := fl.Field()
+		if field.Kind() != reflect.String {
+			return false
+		}
+
+		pin := field.String()
+		if len(pin) < params.minLength || len(pin) > params.maxLength {
+			return false
+		}
+		for i := 0; i < len(pin); i++ {
+			if pin[i] < '0' || pin[i] > '9' {
+				return false
+			}
+		}
+		if isTrivialPIN(pin) {
+			return false
+		}
+
+		if params.noYear && len(pin) == 4 {
+			year, _ := strconv.Atoi(pin)
+			if year >= 1900 && year <= now().Year() {
+				return false
+			}
+		}
+		return true
+	}
+}
+
+// defaultOTPLength is the one-time password length when the otp rule has no parameter.
+const defaultOTPLength = 6
+
+// validateOTP validates one-time password codes, such as those sent by SMS or generated by authenticator
+// apps: exactly the given number of ASCII digits, without whitespace or separators. Leading zeros are kept.
+// Usage:
+//   - `validate:"otp"` - 6 digits
+//   - `validate:"otp=8"` - 8 digits
+func validateOTP(fl validator.FieldLevel) bool {
+	length := defaultOTPLength
+	if param := fl.Param(); param != "" {
+		var err error
+		length, err = strconv.Atoi(param)
+		if err != nil || length <= 0 {
+			panicConfigError(fl, "expected a positive code length")
+		}
+	}
+
+	field := fl.Field()
+	if field.Kind() != reflect.String {
 		return false
 	}
 
+	code := field.String()
+	if len(code) != length {
+		return false
+	}
+	for i := 0; i < len(code); i++ {
+		if code[i] < '0' || code[i] > '9' {
+			return false
+		}
+	}
 	return true
 }
@@ -0,0 +1,304 @@
+package xvalidator
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/shopspring/decimal"
+)
+
+// Conditional validation helper functions
+
+// lookupFieldPath resolves a field by name or dotted path (e.g., "Payment.Method") starting at parent.
+// Pointers and interfaces along the path are dereferenced.
+// found reports whether the path exists on the struct type; the returned value is invalid
+// when a nil pointer or nil interface is met along the way.
+func lookupFieldPath(parent reflect.Value, path string) (value reflect.Value, found bool) {
+	if path == "" || !parent.IsValid() {
+		return reflect.Value{}, false
+	}
+
+	current := parent
+	typ := parent.Type()
+	for _, name := range strings.Split(path, ".") {
+		current, typ = indirectValue(current, typ)
+		if typ == nil {
+			return reflect.Value{}, true
+		}
+		if typ.Kind() != reflect.Struct {
+			return reflect.Value{}, false
+		}
+
+		structField, ok := typ.FieldByName(name)
+		if !ok {
+			return reflect.Value{}, false
+		}
+		typ = structField.Type
+
+		if current.IsValid() {
+			fieldValue, err := current.FieldByIndexErr(structField.Index)
+			if err != nil {
+				fieldValue = reflect.Value{}
+			}
+			current = fieldValue
+		}
+	}
+
+	current, _ = indirectValue(current, typ)
+	return current, true
+}
+
+// indirectValue dereferences pointers and interfaces, returning the underlying value and type.
+// The value becomes invalid once a nil pointer is met; the type is nil when it can no longer be determined.
+func indirectValue(value reflect.Value, typ reflect.Type) (reflect.Value, reflect.Type) {
+	for {
+		switch typ.Kind() {
+		case reflect.Ptr:
+			typ = typ.Elem()
+			if value.IsValid() {
+				if value.IsNil() {
+					value = reflect.Value{}
+				} else {
+					value = value.Elem()
+				}
+			}
+		case reflect.Interface:
+			if !value.IsValid() || value.IsNil() {
+				return reflect.Value{}, nil
+			}
+			value = value.Elem()
+			typ = value.Type()
+		default:
+			return value, typ
+		}
+	}
+}
+
+// formatFieldValue formats a field value as a string for comparison with tag parameters.
+// Strings (including custom string types), booleans, integers, floats, and fmt.Stringer
+// implementations are supported; returns false for invalid values and other kinds.
+func formatFieldValue(field reflect.Value) (string, bool) {
+	if !field.IsValid() {
+		return "", false
+	}
+
+	if field.CanInterface() {
+		if stringer, ok := field.Interface().(fmt.Stringer); ok && field.Kind() != reflect.String {
+			return stringer.String(), true
+		}
+	}
+
+	switch field.Kind() {
+	case reflect.String:
+		return field.String(), true
+	case reflect.Bool:
+		return strconv.FormatBool(field.Bool()), true
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(field.Int(), 10), true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return strconv.FormatUint(field.Uint(), 10), true
+	case reflect.Float32, reflect.Float64:
+		return strconv.FormatFloat(field.Float(), 'f', -1, field.Type().Bits()), true
+	}
+	return "", false
+}
+
+// fieldCondition is a single "Field=value" comparison used by conditional rules.
+type fieldCondition struct {
+	field  string
+	expect string
+}
+
+// fieldConditions is a set of field conditions combined as OR groups (|) of AND groups (&).
+// For example "Type=credit&Region=TH|Type=debit" is parsed as [[Type=credit, Region=TH], [Type=debit]].
+type fieldConditions [][]fieldCondition
+
+// parseFieldConditions parses a condition expression made of "Field=value" pairs joined by & (AND) and | (OR).
+// AND binds tighter than OR. Each pair must contain exactly one "=".
+// Inside struct tags the | separator must be written as 0x7C, since go-playground/validator reserves | for OR-ing rules.
+func parseFieldConditions(expr string) (fieldConditions, error) {
+	var conditions fieldConditions
+	for _, group := range strings.Split(expr, "|") {
+		var all []fieldCondition
+		for _, pair := range strings.Split(group, "&") {
+			parts := strings.Split(pair, "=")
+			if len(parts) != 2 {
+				return nil, validator.ValidationErrors{}
+			}
+			all = append(all, fieldCondition{field: parts[0], expect: parts[1]})
+		}
+		conditions = append(conditions, all)
+	}
+	return conditions, nil
+}
+
+// match evaluates the conditions against fields resolved from parent.
+// found is false when any referenced field does not exist on the parent struct.
+func (c fieldConditions) match(parent reflect.Value) (matched, found bool) {
+	for _, group := range c {
+		groupMatched := true
+		for _, condition := range group {
+			otherField, ok := lookupFieldPath(parent, condition.field)
+			if !ok {
+				return false, false
+			}
+
+			// A nil pointer along the path means the condition cannot match
+			other, ok := formatFieldValue(otherField)
+			if !ok || other != condition.expect {
+				groupMatched = false
+			}
+		}
+		if groupMatched {
+			matched = true
+		}
+	}
+	return matched, true
+}
+
+// String describes the conditions in English, e.g. "Type equals 'credit' and Region equals 'TH'".
+func (c fieldConditions) String() string {
+	groups := make([]string, 0, len(c))
+	for _, group := range c {
+		pairs := make([]string, 0, len(group))
+		for _, condition := range group {
+			pairs = append(pairs, fmt.Sprintf("%s equals '%s'", condition.field, condition.expect))
+		}
+		groups = append(groups, strings.Join(pairs, " and "))
+	}
+	return strings.Join(groups, " or ")
+}
+
+// Generic conditional validation logic functions
+
+// parseWhenParam parses the when parameter.
+// Parameter format: "conditions:rules", where conditions use the same syntax as decimal_if
+// and rules are space-separated validation tags.
+// Examples:
+//   - "Type=credit:required" -> rules="required" when Type equals "credit"
+//   - "Type=credit&Region=TH:required decimal=10:2" -> rules="required,decimal=10:2" when both conditions hold
+//
+// The split happens at the first ":" so rule parameters may contain ":"; condition values may not.
+// Returns conditions, comma-joined rules ready for validator.Var, and error.
+func parseWhenParam(param string) (conditions fieldConditions, rules string, err error) {
+	expr, ruleList, ok := strings.Cut(param, ":")
+	if !ok {
+		return nil, "", validator.ValidationErrors{}
+	}
+
+	conditions, err = parseFieldConditions(expr)
+	if err != nil {
+		return nil, "", err
+	}
+
+	fields := strings.Fields(ruleList)
+	if len(fields) == 0 {
+		return nil, "", validator.ValidationErrors{}
+	}
+
+	return conditions, strings.Join(fields, ","), nil
+}
+
+// validateWhen creates the when meta-validator bound to a validator instance.
+// When the conditions hold, the field value is validated against the listed rules with validator.Var,
+// so any registered rule can be applied conditionally; otherwise validation is skipped.
+// Supports formats:
+//   - when=Type=credit:required -> field is required when Type equals "credit"
+//   - when=Type=credit:required dgt=0 -> field is required and greater than 0 when Type equals "credit"
+//   - when=Type=credit&Region=TH:mobile_e164=TH -> Thai mobile when both conditions hold
+//
+// Rules are evaluated on the field value alone, so cross-field rules cannot be nested inside when.
+// The rule runs even for nil fields, so a nil pointer passes while the conditions do not hold, and the
+// context of StructCtx or VarCtx is passed on to the nested rules.
+// Malformed parameters and references to nonexistent fields panic with *ConfigError.
+func validateWhen(v *validator.Validate) validator.FuncCtx {
+	return func(ctx context.Context, fl validator.FieldLevel) bool {
+		conditions, rules, err := parseWhenParam(fl.Param())
+		if err != nil {
+			panicConfigError(fl, "expected format Field=value:rules")
+		}
+
+		matched, found := conditions.match(fl.Parent())
+		if !found {
+			panicConfigError(fl, "condition references a field that does not exist")
+		}
+		if !matched {
+			return true // Condition not met → skip validation
+		}
+
+		var value any
+		if field := fl.Field(); field.IsValid() {
+			value = field.Interface()
+		}
+		return v.VarCtx(ctx, value, rules) == nil
+	}
+}
+
+// Decimal-conditioned required validation logic functions
+
+// parseRequiredIfDecimalParam parses the required_if_d* parameter.
+// Parameter format: "field:threshold"
+// Examples:
+//   - "Amount:10000" -> field="Amount", threshold=10000
+//   - "Payment.Amount:50000.50" -> field="Payment.Amount", threshold=50000.50
+func parseRequiredIfDecimalParam(param string) (field string, threshold decimal.Decimal, err error) {
+	field, value, ok := strings.Cut(param, ":")
+	if !ok || field == "" {
+		return "", decimal.Decimal{}, validator.ValidationErrors{}
+	}
+
+	threshold, err = decimal.NewFromString(value)
+	if err != nil {
+		return "", decimal.Decimal{}, err
+	}
+
+	return field, threshold, nil
+}
+
+// hasValue reports whether a field holds a non-zero value, mirroring the semantics of the required rule.
+func hasValue(field reflect.Value) bool {
+	switch field.Kind() {
+	case reflect.Slice, reflect.Map, reflect.Ptr, reflect.Interface, reflect.Chan, reflect.Func:
+		return !field.IsNil()
+	case reflect.Invalid:
+		return false
+	case reflect.Struct:
+		// Struct fields (including dereferenced non-nil pointers) are treated as present, as with required
+		return true
+	default:
+		return !field.IsZero()
+	}
+}
+
+// validateRequiredIfDecimal creates a validator function that requires the field
+// when a sibling decimal field compares true against a threshold.
+// Supports formats:
+//   - required_if_dgt=Amount:10000 -> required when Amount > 10000
+//   - required_if_dgte=Amount:10000 -> required when Amount >= 10000
+//
+// A sibling value that is not a valid decimal does not trigger the requirement.
+// Malformed parameters and references to nonexistent fields panic with *ConfigError.
+func validateRequiredIfDecimal(comparator func(d1, d2 *decimal.Decimal) bool) validator.Func {
+	return func(fl validator.FieldLevel) bool {
+		field, threshold, err := parseRequiredIfDecimalParam(fl.Param())
+		if err != nil {
+			panicConfigError(fl, "expected format Field:threshold")
+		}
+
+		otherField, found := lookupFieldPath(fl.Parent(), field)
+		if !found {
+			panicConfigError(fl, "condition references a field that does not exist")
+		}
+
+		other, ok := decimalFieldValue(otherField)
+		if !ok || !comparator(&other, &threshold) {
+			return true // Condition not met → field is optional
+		}
+
+		return hasValue(fl.Field())
+	}
+}
@@ -0,0 +1,312 @@
+package xvalidator
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLookupFieldPath(t *testing.T) {
+	type Inner struct {
+		Method string
+	}
+
+	type Outer struct {
+		Inner    Inner
+		InnerPtr *Inner
+		Any      any
+	}
+
+	value := reflect.ValueOf(Outer{Inner: Inner{Method: "cash"}, InnerPtr: &Inner{Method: "card"}, Any: Inner{Method: "wire"}})
+
+	tests := []struct {
+		name     string
+		parent   reflect.Value
+		path     string
+		expected string
+		found    bool
+		valid    bool
+	}{
+		{"nested value field", value, "Inner.Method", "cash", true, true},
+		{"nested pointer field", value, "InnerPtr.Method", "card", true, true},
+		{"interface field", value, "Any.Method", "wire", true, true},
+		{"pointer parent", reflect.ValueOf(&Inner{Method: "pp"}), "Method", "pp", true, true},
+		{"nil pointer along path", reflect.ValueOf(Outer{}), "InnerPtr.Method", "", true, false},
+		{"missing field", value, "Inner.Missing", "", false, false},
+		{"path through non-struct", value, "Inner.Method.Length", "", false, false},
+		{"empty path", value, "", "", false, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			field, found := lookupFieldPath(tt.parent, tt.path)
+			assert.Equal(t, tt.found, found)
+			assert.Equal(t, tt.valid, field.IsValid())
+			if tt.valid {
+				assert.Equal(t, tt.expected, field.String())
+			}
+		})
+	}
+}
+
+func TestFormatFieldValue(t *testing.T) {
+	type Mode string
+
+	tests := []struct {
+		name     string
+		input    any
+		expected string
+		ok       bool
+	}{
+		{"string", "cash", "cash", true},
+		{"custom string", Mode("card"), "card", true},
+		{"bool", true, "true", true},
+		{"int", -7, "-7", true},
+		{"uint", uint16(7), "7", true},
+		{"float", 2.50, "2.5", true},
+		{"stringer", decimal.RequireFromString("1.20"), "1.2", true},
+		{"slice", []int{1}, "", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			formatted, ok := formatFieldValue(reflect.ValueOf(tt.input))
+			assert.Equal(t, tt.ok, ok)
+			assert.Equal(t, tt.expected, formatted)
+		})
+	}
+}
+
+func TestFieldConditionsString(t *testing.T) {
+	conditions, err := parseFieldConditions("Type=credit&Region=TH|Type=debit")
+	require.NoError(t, err)
+	assert.Equal(t, "Type equals 'credit' and Region equals 'TH' or Type equals 'debit'", conditions.String())
+}
+
+func TestParseWhenParam(t *testing.T) {
+	tests := []struct {
+		name          string
+		param         string
+		expectedRules string
+		expectedText  string
+		expectError   bool
+	}{
+		{
+			name:          "single rule",
+			param:         "Type=credit:required",
+			expectedRules: "required",
+			expectedText:  "Type equals 'credit'",
+		},
+		{
+			name:          "multiple rules with colon parameter",
+			param:         "Type=credit&Region=TH:required decimal=10:2",
+			expectedRules: "required,decimal=10:2",
+			expectedText:  "Type equals 'credit' and Region equals 'TH'",
+		},
+		{
+			name:          "OR conditions",
+			param:         "Type=credit|Type=debit:dgt=0",
+			expectedRules: "dgt=0",
+			expectedText:  "Type equals 'credit' or Type equals 'debit'",
+		},
+		{name: "missing rules separator", param: "Type=credit", expectError: true},
+		{name: "empty rules", param: "Type=credit: ", expectError: true},
+		{name: "malformed condition", param: "Type:required", expectError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			conditions, rules, err := parseWhenParam(tt.param)
+			if tt.expectError {
+				assert.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedRules, rules)
+			assert.Equal(t, tt.expectedText, conditions.String())
+		})
+	}
+}
+
+func TestValidateWhen(t *testing.T) {
+	v := validator.New()
+	RegisterDecimalValidators(v)
+	RegisterConditionalValidators(v)
+
+	type Payment struct {
+		Method       string
+		Region       string
+		CardNumber   string `validate:"when=Method=card:required numeric len=16"`
+		Amount       string `validate:"when=Method=cash:decimal=10:0"`
+		Phone        string `validate:"when=Method=wallet&Region=TH:required e164"`
+		ApprovalCode string `validate:"when=Method=card0x7CMethod=transfer:required"`
+	}
+
+	tests := []struct {
+		name    string
+		input   Payment
+		wantErr bool
+	}{
+		{"conditions not met", Payment{Method: "other", Amount: "1.5"}, false},
+		{"card rules satisfied", Payment{Method: "card", CardNumber: "4111111111111111", ApprovalCode: "A1"}, false},
+		{"card required missing", Payment{Method: "card", ApprovalCode: "A1"}, true},
+		{"card length invalid", Payment{Method: "card", CardNumber: "4111", ApprovalCode: "A1"}, true},
+		{"decimal rule applied", Payment{Method: "cash", Amount: "1.5"}, true},
+		{"decimal rule satisfied", Payment{Method: "cash", Amount: "15"}, false},
+		{"AND conditions met", Payment{Method: "wallet", Region: "TH"}, true},
+		{"AND conditions partially met", Payment{Method: "wallet", Region: "US"}, false},
+		{"OR alternative met", Payment{Method: "transfer"}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := v.Struct(tt.input)
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestValidateWhen_NilPointer(t *testing.T) {
+	v := validator.New()
+	RegisterConditionalValidators(v)
+
+	type Payment struct {
+		Kind string
+		Note *string `validate:"when=Kind=credit:required"`
+	}
+
+	note := "approved"
+	assert.NoError(t, v.Struct(Payment{Kind: "cash"}))
+	assert.Error(t, v.Struct(Payment{Kind: "credit"}))
+	assert.NoError(t, v.Struct(Payment{Kind: "credit", Note: &note}))
+}
+
+func TestValidateWhen_Context(t *testing.T) {
+	type ctxKey struct{}
+
+	v := validator.New()
+	RegisterConditionalValidators(v)
+	v.RegisterValidationCtx("ctx_marker", func(ctx context.Context, fl validator.FieldLevel) bool {
+		return ctx.Value(ctxKey{}) == "set"
+	})
+
+	type Payment struct {
+		Kind string
+		Note string `validate:"when=Kind=credit:ctx_marker"`
+	}
+
+	payment := Payment{Kind: "credit", Note: "x"}
+	assert.NoError(t, v.StructCtx(context.WithValue(context.Background(), ctxKey{}, "set"), payment))
+	assert.Error(t, v.StructCtx(context.Background(), payment))
+}
+
+func TestValidateWhen_MissingField(t *testing.T) {
+	v := validator.New()
+	RegisterConditionalValidators(v)
+
+	type Invalid struct {
+		Value string `validate:"when=Missing=x:required"`
+	}
+
+	assert.PanicsWithError(t, "xvalidator: invalid configuration for tag 'when=Missing=x:required': condition references a field that does not exist", func() {
+		_ = v.Struct(Invalid{})
+	})
+}
+
+func TestParseRequiredIfDecimalParam(t *testing.T) {
+	tests := []struct {
+		name              string
+		param             string
+		expectedField     string
+		expectedThreshold string
+		expectError       bool
+	}{
+		{"simple field", "Amount:10000", "Amount", "10000", false},
+		{"nested field with decimal threshold", "Payment.Amount:50000.50", "Payment.Amount", "50000.5", false},
+		{"missing separator", "Amount", "", "", true},
+		{"missing field", ":100", "", "", true},
+		{"invalid threshold", "Amount:abc", "", "", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			field, threshold, err := parseRequiredIfDecimalParam(tt.param)
+			if tt.expectError {
+				assert.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedField, field)
+			assert.Equal(t, tt.expectedThreshold, threshold.String())
+		})
+	}
+}
+
+func TestValidateRequiredIfDecimal(t *testing.T) {
+	v := validator.New()
+	RegisterDecimalValidators(v)
+	RegisterConditionalValidators(v)
+
+	type Approval struct {
+		Code string
+	}
+
+	type Payment struct {
+		Amount       string
+		Limit        decimal.Decimal
+		ApprovalCode string    `validate:"required_if_dgt=Amount:10000"`
+		Approval     *Approval `validate:"required_if_dgte=Amount:50000"`
+		Reason       string    `validate:"required_if_dlt=Limit:0"`
+		Note         string    `validate:"required_if_dlte=Amount:0"`
+	}
+
+	tests := []struct {
+		name    string
+		input   Payment
+		wantErr bool
+	}{
+		{"below threshold", Payment{Amount: "10000.00"}, false},
+		{"above threshold with code", Payment{Amount: "10000.01", ApprovalCode: "A1"}, false},
+		{"above threshold without code", Payment{Amount: "10000.01"}, true},
+		{"nil pointer required at threshold", Payment{Amount: "50000", ApprovalCode: "A1"}, true},
+		{"pointer provided at threshold", Payment{Amount: "50000", ApprovalCode: "A1", Approval: &Approval{}}, false},
+		{"negative decimal.Decimal limit", Payment{Amount: "1", Limit: decimal.NewFromInt(-1)}, true},
+		{"zero amount requires note", Payment{Amount: "0"}, true},
+		{"invalid amount does not trigger", Payment{Amount: "n/a"}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := v.Struct(tt.input)
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestValidateRequiredIfDecimal_MissingField(t *testing.T) {
+	v := validator.New()
+	RegisterConditionalValidators(v)
+
+	type Invalid struct {
+		Code string `validate:"required_if_dgt=Missing:10"`
+	}
+
+	assert.Panics(t, func() {
+		_ = v.Struct(Invalid{})
+	})
+}
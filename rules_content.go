@@ -0,0 +1,206 @@
+package xvalidator
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"io"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/go-playground/validator/v10"
+	"gopkg.in/yaml.v3"
+)
+
+// lengthBounds is an inclusive length range; max is -1 when unbounded.
+type lengthBounds struct {
+	min, max int
+}
+
+// parseLengthBounds parses "N" (exactly N), "min:max", "min:" or ":max"; an empty parameter is unbounded.
+func parseLengthBounds(param string) (lengthBounds, bool) {
+	if param == "" {
+		return lengthBounds{max: -1}, true
+	}
+
+	minText, maxText, isRange := strings.Cut(param, ":")
+	if !isRange {
+		n, err := strconv.Atoi(param)
+		return lengthBounds{min: n, max: n}, err == nil && n >= 0
+	}
+
+	bounds := lengthBounds{max: -1}
+	if minText != "" {
+		n, err := strconv.Atoi(minText)
+		if err != nil || n < 0 {
+			return lengthBounds{}, false
+		}
+		bounds.min = n
+	}
+	if maxText != "" {
+		n, err := strconv.Atoi(maxText)
+		if err != nil || n < bounds.min {
+			return lengthBounds{}, false
+		}
+		bounds.max = n
+	}
+	return bounds, true
+}
+
+// contains reports whether n lies within the bounds.
+func (b lengthBounds) contains(n int) bool {
+	return n >= b.min && (b.max < 0 || n <= b.max)
+}
+
+// validateBase64URL validates base64url strings (RFC 4648 section 5), padded or unpadded, whose decoded
+// length in bytes lies within optional bounds. Standard base64 characters ("+" and "/"), line breaks and
+// empty strings are rejected. It replaces go-playground/validator's base64url rule, which requires padding.
+// Usage:
+//   - `validate:"base64url"` - e.g., "c2VjcmV0LWtleQ"
+//   - `validate:"base64url=32"` - a 256-bit key
+//   - `validate:"base64url=16:64"` - nonces or webhook secrets of 16 to 64 bytes
+func validateBase64URL(fl validator.FieldLevel) bool {
+	bounds, ok := parseLengthBounds(fl.Param())
+	if !ok {
+		panicConfigError(fl, "expected a decoded length N or bounds min:max in bytes")
+	}
+
+	field := fl.Field()
+	if field.Kind() != reflect.String || field.String() == "" {
+		return false
+	}
+
+	// The decoder skips CR and LF even in strict mode, so reject them before decoding
+	value := field.String()
+	if strings.ContainsAny(value, "\r\n") {
+		return false
+	}
+	encoding := base64.RawURLEncoding
+	if strings.HasSuffix(value, "=") {
+		encoding = base64.URLEncoding
+	}
+	decoded, err := encoding.Strict().DecodeString(value)
+	return err == nil && bounds.contains(len(decoded))
+}
+
+// fieldBytes returns the content of a string or byte slice field, such as json.RawMessage.
+func fieldBytes(field reflect.Value) ([]byte, bool) {
+	switch {
+	case field.Kind() == reflect.String:
+		return []byte(field.String()), true
+	case field.Kind() == reflect.Slice && field.Type().Elem().Kind() == reflect.Uint8:
+		return field.Bytes(), true
+	default:
+		return nil, false
+	}
+}
+
+// validateJSONString validates that a string or byte slice field (such as json.RawMessage) holds
+// syntactically valid JSON. The "object" or "array" parameter restricts the top-level value.
+// Usage:
+//   - `validate:"json_string"` - any JSON value, e.g., `{"a":1}`, `[1,2]` or `"text"`
+//   - `validate:"json_string=object"` - a JSON object
+//   - `validate:"json_string=array"` - a JSON array
+func validateJSONString(fl validator.FieldLevel) bool {
+	var opening byte
+	switch fl.Param() {
+	case "":
+	case "object":
+		opening = '{'
+	case "array":
+		opening = '['
+	default:
+		panicConfigError(fl, "expected no parameter, object or array")
+	}
+
+	content, ok := fieldBytes(fl.Field())
+	if !ok || !json.Valid(content) {
+		return false
+	}
+	return opening == 0 || bytes.TrimLeft(content, " \t\r\n")[0] == opening
+}
+
+// validateYAMLString validates that a string or byte slice field holds well-formed YAML: every document of
+// the stream parses, mapping keys are unique and aliases are not excessively nested. Empty content is invalid.
+// Usage:
+//   - `validate:"yaml_string"` - e.g., "replicas: 3\nimage: nginx:1.27"
+func validateYAMLString(fl validator.FieldLevel) bool {
+	content, ok := fieldBytes(fl.Field())
+	if !ok || len(bytes.TrimSpace(content)) == 0 {
+		return false
+	}
+
+	decoder := yaml.NewDecoder(bytes.NewReader(content))
+	for {
+		var document any
+		err := decoder.Decode(&document)
+		if errors.Is(err, io.EOF) {
+			return true
+		}
+		if err != nil {
+			return false
+		}
+	}
+}
+
+// patternRegistry holds the named patterns registered with Validator.RegisterPattern for the regex rule.
+type patternRegistry struct {
+	patterns sync.Map // name -> *regexp.Regexp
+}
+
+// lookup returns the pattern registered under name.
+func (r *patternRegistry) lookup(name string) (*regexp.Regexp, bool) {
+	regex, ok := r.patterns.Load(name)
+	if !ok {
+		return nil, false
+	}
+	return regex.(*regexp.Regexp), true
+}
+
+// isPatternName reports whether param looks like a pattern name: a letter or underscore followed by letters,
+// digits and underscores.
+func isPatternName(param string) bool {
+	for i, r := range param {
+		switch {
+		case r == '_', r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z':
+		case r >= '0' && r <= '9' && i > 0:
+		default:
+			return false
+		}
+	}
+	return param != ""
+}
+
+// validateRegex returns a validator checking that a string matches a regular expression (RE2 syntax): a
+// pattern registered with Validator.RegisterPattern, or otherwise the parameter itself, compiled once and cached.
+// Inline patterns are not anchored implicitly; inside struct tags write "," as 0x2C and "|" as 0x7C.
+// A parameter that looks like a name (e.g. order_id) but is not registered is a ConfigError rather than an
+// inline pattern, so a missing registration cannot turn into a substring match; write (?:order_id) to match
+// such text literally. An invalid inline pattern is a ConfigError too.
+// Usage:
+//   - `validate:"regex=order_id"` - a named pattern
+//   - `validate:"regex=^[A-Z]{3}-[0-9]{6}$"` - an inline pattern
+func validateRegex(patterns *patternRegistry) validator.Func {
+	return func(fl validator.FieldLevel) bool {
+		regex, ok := patterns.lookup(fl.Param())
+		if !ok {
+			if isPatternName(fl.Param()) {
+				panicConfigError(fl, "unknown pattern name; register it with Validator.RegisterPattern")
+			}
+			var err error
+			if regex, err = compileTagRegex(fl.Param()); err != nil || fl.Param() == "" {
+				panicConfigError(fl, "expected a registered pattern name or a valid regular expression")
+			}
+		}
+
+		field := fl.Field()
+		if field.Kind() != reflect.String {
+			return false
+		}
+		return regex.MatchString(field.String())
+	}
+}
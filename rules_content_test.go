@@ -0,0 +1,224 @@
+package xvalidator
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateBase64URL(t *testing.T) {
+	v, err := NewValidator()
+	require.NoError(t, err)
+
+	key := base64.RawURLEncoding.EncodeToString([]byte(strings.Repeat("k", 32)))
+	paddedKey := base64.URLEncoding.EncodeToString([]byte(strings.Repeat("k", 32)))
+
+	tests := []struct {
+		name    string
+		value   string
+		tag     string
+		wantErr bool
+	}{
+		{"unpadded", "c2VjcmV0LWtleQ", "base64url", false},
+		{"padded", "c2VjcmV0LWtleQ==", "base64url", false},
+		{"url-safe characters", "-_-_", "base64url", false},
+		{"exact length", key, "base64url=32", false},
+		{"exact length padded", paddedKey, "base64url=32", false},
+		{"within range", key, "base64url=16:64", false},
+		{"minimum only", key, "base64url=16:", false},
+		{"maximum only", key, "base64url=:32", false},
+		{"empty", "", "base64url", true},
+		{"standard base64 characters", "c2VjcmV0+2V5/w", "base64url", true},
+		{"invalid length", "c2VjcmV0LWtle", "base64url", true},
+		{"wrong padding", "c2VjcmV0LWtleQ=", "base64url", true},
+		{"non-zero trailing bits", "c2VjcmV0LWtleR", "base64url", true},
+		{"line feed", "c2Vj\ncmV0", "base64url", true},
+		{"carriage return and line feed", "c2Vj\r\ncmV0", "base64url", true},
+		{"trailing line feed", "c2VjcmV0\n", "base64url", true},
+		{"too short", key, "base64url=33", true},
+		{"below minimum", key, "base64url=33:64", true},
+		{"above maximum", key, "base64url=:31", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := v.Var(tt.value, tt.tag)
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+
+	t.Run("invalid bounds are a config error", func(t *testing.T) {
+		for _, tag := range []string{"base64url=x", "base64url=64:16", "base64url=-1"} {
+			err := v.Var(key, tag)
+			var configErr *ConfigError
+			require.ErrorAs(t, err, &configErr, tag)
+			assert.Equal(t, "base64url", configErr.Tag)
+		}
+	})
+}
+
+func TestValidateJSONString(t *testing.T) {
+	v, err := NewValidator()
+	require.NoError(t, err)
+
+	tests := []struct {
+		name    string
+		value   any
+		tag     string
+		wantErr bool
+	}{
+		{"object", `{"name":"Jane","tags":["a","b"]}`, "json_string", false},
+		{"array", `[1, 2, 3]`, "json_string", false},
+		{"scalar", `"text"`, "json_string", false},
+		{"number", `42`, "json_string", false},
+		{"object with whitespace", " \n {\"a\": 1} ", "json_string=object", false},
+		{"array restricted", `[{"a":1}]`, "json_string=array", false},
+		{"raw message", json.RawMessage(`{"a":1}`), "json_string=object", false},
+		{"byte slice", []byte(`[true]`), "json_string=array", false},
+		{"empty", "", "json_string", true},
+		{"truncated", `{"a":`, "json_string", true},
+		{"trailing comma", `{"a":1,}`, "json_string", true},
+		{"single quotes", `{'a':1}`, "json_string", true},
+		{"two values", `{} {}`, "json_string", true},
+		{"array is not an object", `[1]`, "json_string=object", true},
+		{"object is not an array", `{"a":1}`, "json_string=array", true},
+		{"scalar is not an object", `"{}"`, "json_string=object", true},
+		{"non-string field", 42, "json_string", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := v.Var(tt.value, tt.tag)
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+
+	t.Run("invalid parameter is a config error", func(t *testing.T) {
+		err := v.Var(`{}`, "json_string=map")
+		var configErr *ConfigError
+		require.ErrorAs(t, err, &configErr)
+		assert.Equal(t, "json_string", configErr.Tag)
+	})
+}
+
+func TestValidateYAMLString(t *testing.T) {
+	v, err := NewValidator()
+	require.NoError(t, err)
+
+	tests := []struct {
+		name    string
+		value   any
+		wantErr bool
+	}{
+		{"mapping", "replicas: 3\nimage: nginx:1.27\nports:\n  - 80\n  - 443\n", false},
+		{"sequence", "- a\n- b\n", false},
+		{"scalar", "hello", false},
+		{"multiple documents", "name: a\n---\nname: b\n", false},
+		{"anchors and aliases", "base: &base {cpu: 1}\nweb: *base\n", false},
+		{"byte slice", []byte("enabled: true\n"), false},
+		{"empty", "", true},
+		{"whitespace only", " \n\t", true},
+		{"unclosed flow sequence", "key: [unclosed", true},
+		{"bad indentation", "a:\n  b: 1\n c: 2\n", true},
+		{"duplicate key", "name: a\nname: b\n", true},
+		{"tab indentation", "a:\n\tb: 1\n", true},
+		{"invalid second document", "name: a\n---\nkey: [unclosed\n", true},
+		{"undefined alias", "web: *missing\n", true},
+		{"non-string field", 42, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := v.Var(tt.value, "yaml_string")
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestValidateRegex(t *testing.T) {
+	v, err := NewValidator()
+	require.NoError(t, err)
+	require.NoError(t, v.RegisterPattern("order_id", `^ORD-[0-9]{6}$`))
+
+	tests := []struct {
+		name    string
+		value   string
+		tag     string
+		wantErr bool
+	}{
+		{"named pattern", "ORD-123456", "regex=order_id", false},
+		{"named pattern mismatch", "ORD-12345", "regex=order_id", true},
+		{"inline pattern", "ABC-123", "regex=^[A-Z]{3}-[0-9]{3}$", false},
+		{"inline pattern mismatch", "abc-123", "regex=^[A-Z]{3}-[0-9]{3}$", true},
+		{"inline pattern is not anchored", "ref: ABC-123", "regex=[A-Z]{3}-[0-9]{3}", false},
+		{"encoded comma and pipe", "b", "regex=^(a0x7Cb){10x2C2}$", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := v.Var(tt.value, tt.tag)
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+
+	t.Run("patterns are compiled once", func(t *testing.T) {
+		require.NoError(t, v.Var("ABC-123", "regex=^[A-Z]{3}-[0-9]{3}$"))
+		first, err := compileTagRegex("^[A-Z]{3}-[0-9]{3}$")
+		require.NoError(t, err)
+		require.NoError(t, v.Var("XYZ-789", "regex=^[A-Z]{3}-[0-9]{3}$"))
+		second, err := compileTagRegex("^[A-Z]{3}-[0-9]{3}$")
+		require.NoError(t, err)
+		assert.Same(t, first, second)
+	})
+
+	t.Run("named patterns are per validator", func(t *testing.T) {
+		other, err := NewValidator()
+		require.NoError(t, err)
+		// Without the registration, "order_id" is a config error rather than an inline pattern
+		for _, value := range []string{"ORD-123456", "order_id", "id: order_id"} {
+			var configErr *ConfigError
+			require.ErrorAs(t, other.Var(value, "regex=order_id"), &configErr)
+			assert.Equal(t, "regex", configErr.Tag)
+			assert.Equal(t, "order_id", configErr.Param)
+		}
+		assert.NoError(t, other.Var("order_id", "regex=(?:order_id)"))
+	})
+
+	t.Run("translated named pattern", func(t *testing.T) {
+		err := v.VarTranslated("ORD-1", "regex=order_id")
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "must be a valid order id")
+	})
+
+	t.Run("invalid registration", func(t *testing.T) {
+		assert.Error(t, v.RegisterPattern("broken", `^[A-Z`))
+		assert.Error(t, v.RegisterPattern("", `^[A-Z]$`))
+	})
+
+	t.Run("invalid inline pattern is a config error", func(t *testing.T) {
+		err := v.Var("ABC", "regex=^[A-Z")
+		var configErr *ConfigError
+		require.ErrorAs(t, err, &configErr)
+		assert.Equal(t, "regex", configErr.Tag)
+	})
+}
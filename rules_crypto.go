@@ -0,0 +1,262 @@
+package xvalidator
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"math/big"
+	"reflect"
+	"strings"
+
+	"github.com/go-playground/validator/v10"
+	"golang.org/x/crypto/sha3"
+)
+
+// Cryptocurrency address validation logic functions
+
+const (
+	// base58Alphabet is the Bitcoin Base58 alphabet (no 0, O, I or l).
+	base58Alphabet = "123456789ABCDEFGHJKLMNPQRSTUVWXYZabcdefghijkmnopqrstuvwxyz"
+
+	// bech32Charset is the BIP-173 data character set.
+	bech32Charset = "qpzry9x8gf2tvdw0s3jn54khce6mua7l"
+
+	// bech32Const and bech32mConst are the checksum constants of BIP-173 and BIP-350.
+	bech32Const  = 1
+	bech32mConst = 0x2bc830a3
+)
+
+// btcNetwork describes the address prefixes of a Bitcoin network.
+type btcNetwork struct {
+	hrp      string
+	versions []byte // Base58Check version bytes (P2PKH, P2SH)
+}
+
+var (
+	btcMainnet = btcNetwork{hrp: "bc", versions: []byte{0x00, 0x05}}
+	btcTestnet = btcNetwork{hrp: "tb", versions: []byte{0x6f, 0xc4}}
+)
+
+// base58Decode decodes a Base58 string, preserving leading zero bytes encoded as '1'.
+func base58Decode(s string) ([]byte, bool) {
+	num := new(big.Int)
+	radix := big.NewInt(58)
+	for i := 0; i < len(s); i++ {
+		digit := strings.IndexByte(base58Alphabet, s[i])
+		if digit < 0 {
+			return nil, false
+		}
+		num.Mul(num, radix)
+		num.Add(num, big.NewInt(int64(digit)))
+	}
+
+	leadingZeros := 0
+	for leadingZeros < len(s) && s[leadingZeros] == '1' {
+		leadingZeros++
+	}
+	return append(make([]byte, leadingZeros), num.Bytes()...), true
+}
+
+// btcBase58CheckValid reports whether address is a legacy (P2PKH or P2SH) Base58Check address of the network.
+func btcBase58CheckValid(address string, network btcNetwork) bool {
+	if len(address) < 26 || len(address) > 35 {
+		return false
+	}
+
+	decoded, ok := base58Decode(address)
+	if !ok || len(decoded) != 25 {
+		return false
+	}
+
+	versionOK := false
+	for _, version := range network.versions {
+		if decoded[0] == version {
+			versionOK = true
+			break
+		}
+	}
+	if !versionOK {
+		return false
+	}
+
+	first := sha256.Sum256(decoded[:21])
+	second := sha256.Sum256(first[:])
+	return string(second[:4]) == string(decoded[21:])
+}
+
+// bech32Polymod computes the BIP-173 checksum polynomial over the given 5-bit values.
+func bech32Polymod(values []byte) uint32 {
+	generators := [5]uint32{0x3b6a57b2, 0x26508e6d, 0x1ea119fa, 0x3d4233dd, 0x2a1462b3}
+	chk := uint32(1)
+	for _, v := range values {
+		top := chk >> 25
+		chk = (chk&0x1ffffff)<<5 ^ uint32(v)
+		for i := 0; i < 5; i++ {
+			if (top>>i)&1 == 1 {
+				chk ^= generators[i]
+			}
+		}
+	}
+	return chk
+}
+
+// btcSegwitValid reports whether address is a native SegWit address of the network:
+// bech32 for witness version 0 (P2WPKH, P2WSH) and bech32m for versions 1-16 (e.g., Taproot).
+func btcSegwitValid(address string, network btcNetwork) bool {
+	if len(address) > 90 {
+		return false
+	}
+	lower := strings.ToLower(address)
+	if lower != address && strings.ToUpper(address) != address {
+		return false // mixed case is not allowed
+	}
+
+	sep := strings.LastIndexByte(lower, '1')
+	if sep < 0 || lower[:sep] != network.hrp || len(lower)-sep-1 < 7 {
+		return false
+	}
+
+	// Expand the human-readable part and append the data values for checksum verification
+	hrp := lower[:sep]
+	values := make([]byte, 0, len(hrp)*2+1+len(lower)-sep-1)
+	for i := 0; i < len(hrp); i++ {
+		values = append(values, hrp[i]>>5)
+	}
+	values = append(values, 0)
+	for i := 0; i < len(hrp); i++ {
+		values = append(values, hrp[i]&31)
+	}
+	dataStart := len(values)
+	for i := sep + 1; i < len(lower); i++ {
+		v := strings.IndexByte(bech32Charset, lower[i])
+		if v < 0 {
+			return false
+		}
+		values = append(values, byte(v))
+	}
+	data := values[dataStart : len(values)-6]
+	if len(data) == 0 {
+		return false
+	}
+
+	witnessVersion := data[0]
+	if witnessVersion > 16 {
+		return false
+	}
+	expectedConst := uint32(bech32mConst)
+	if witnessVersion == 0 {
+		expectedConst = bech32Const
+	}
+	if bech32Polymod(values) != expectedConst {
+		return false
+	}
+
+	// Convert the witness program from 5-bit groups to bytes without padding
+	acc, bits, programLength := 0, 0, 0
+	for _, v := range data[1:] {
+		acc = acc<<5 | int(v)
+		bits += 5
+		if bits >= 8 {
+			bits -= 8
+			programLength++
+		}
+	}
+	if bits >= 5 || acc&(1<<bits-1) != 0 {
+		return false
+	}
+
+	if witnessVersion == 0 {
+		return programLength == 20 || programLength == 32
+	}
+	return programLength >= 2 && programLength <= 40
+}
+
+// validateBTCAddress validates Bitcoin addresses: legacy Base58Check (P2PKH, P2SH) and
+// native SegWit bech32/bech32m (P2WPKH, P2WSH, Taproot) with checksum verification.
+// Usage:
+//   - `validate:"btc_address"` - mainnet addresses
+//   - `validate:"btc_address=testnet"` - testnet addresses
+func validateBTCAddress(fl validator.FieldLevel) bool {
+	var network btcNetwork
+	switch fl.Param() {
+	case "", "mainnet":
+		network = btcMainnet
+	case "testnet":
+		network = btcTestnet
+	default:
+		panicConfigError(fl, "expected no parameter, 'mainnet' or 'testnet'")
+	}
+
+	field := fl.Field()
+	if field.Kind() != reflect.String {
+		return false
+	}
+
+	address := field.String()
+	return btcBase58CheckValid(address, network) || btcSegwitValid(address, network)
+}
+
+// ethChecksumValid reports whether the 40 hex characters of an Ethereum address match their EIP-55 mixed-case checksum.
+func ethChecksumValid(hexAddress string) bool {
+	h := sha3.NewLegacyKeccak256()
+	_, _ = h.Write([]byte(strings.ToLower(hexAddress)))
+	hash := hex.EncodeToString(h.Sum(nil))
+
+	for i := 0; i < len(hexAddress); i++ {
+		c := hexAddress[i]
+		if c <= '9' {
+			continue
+		}
+		upper := c >= 'A' && c <= 'F'
+		if (hash[i] >= '8') != upper {
+			return false
+		}
+	}
+	return true
+}
+
+// validateETHAddress validates Ethereum addresses ("0x" followed by 40 hex characters).
+// Mixed-case addresses must match their EIP-55 checksum; all-lowercase and all-uppercase
+// addresses carry no checksum and are accepted unless the "checksum" parameter is given.
+// Usage:
+//   - `validate:"eth_address"` - any valid address
+//   - `validate:"eth_address=checksum"` - EIP-55 checksummed addresses only
+func validateETHAddress(fl validator.FieldLevel) bool {
+	var requireChecksum bool
+	switch fl.Param() {
+	case "":
+	case "checksum":
+		requireChecksum = true
+	default:
+		panicConfigError(fl, "expected no parameter or 'checksum'")
+	}
+
+	field := fl.Field()
+	if field.Kind() != reflect.String {
+		return false
+	}
+
+	address := field.String()
+	if len(address) != 42 || address[0] != '0' || address[1] != 'x' {
+		return false
+	}
+	hexAddress := address[2:]
+
+	hasLower, hasUpper := false, false
+	for i := 0; i < len(hexAddress); i++ {
+		c := hexAddress[i]
+		switch {
+		case c >= '0' && c <= '9':
+		case c >= 'a' && c <= 'f':
+			hasLower = true
+		case c >= 'A' && c <= 'F':
+			hasUpper = true
+		default:
+			return false
+		}
+	}
+
+	if requireChecksum || (hasLower && hasUpper) {
+		return ethChecksumValid(hexAddress)
+	}
+	return true
+}
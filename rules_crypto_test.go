@@ -0,0 +1,112 @@
+package xvalidator
+
+import (
+	"testing"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateBTCAddress(t *testing.T) {
+	v := validator.New()
+	RegisterPaymentValidators(v)
+
+	tests := []struct {
+		name    string
+		value   interface{}
+		tag     string
+		wantErr bool
+	}{
+		{name: "valid P2PKH", value: "1BvBMSEYstWetqTFn5Au4m4GFg7xJaNVN2", tag: "btc_address", wantErr: false},
+		{name: "valid genesis P2PKH", value: "1A1zP1eP5QGefi2DMPTfTL5SLmv7DivfNa", tag: "btc_address", wantErr: false},
+		{name: "valid P2SH", value: "3J98t1WpEZ73CNmQviecrnyiWrnqRhWNLy", tag: "btc_address", wantErr: false},
+		{name: "valid P2WPKH", value: "bc1qar0srrr7xfkvy5l643lydnw9re59gtzzwf5mdq", tag: "btc_address", wantErr: false},
+		{name: "valid uppercase P2WPKH", value: "BC1QW508D6QEJXTDG4Y5R3ZARVARY0C5XW7KV8F3T4", tag: "btc_address", wantErr: false},
+		{name: "valid P2WSH", value: "bc1qrp33g0q5c5txsp9arysrx4k6zdkfs4nce4xj0gdcccefvpysxf3qccfmv3", tag: "btc_address", wantErr: false},
+		{name: "valid taproot", value: "bc1p0xlxvlhemja6c4dqv22uapctqupfhlxm9h8z3k2e72q4k9hcz7vqzk5jj0", tag: "btc_address", wantErr: false},
+		{name: "base58 checksum failure", value: "1BvBMSEYstWetqTFn5Au4m4GFg7xJaNVN3", tag: "btc_address", wantErr: true},
+		{name: "base58 invalid character", value: "1BvBMSEYstWetqTFn5Au4m4GFg7xJaNVN0", tag: "btc_address", wantErr: true},
+		{name: "bech32 checksum failure", value: "bc1qar0srrr7xfkvy5l643lydnw9re59gtzzwf5mdr", tag: "btc_address", wantErr: true},
+		{name: "taproot with bech32 checksum", value: "bc1p0xlxvlhemja6c4dqv22uapctqupfhlxm9h8z3k2e72q4k9hcz7vqh2y7hd", tag: "btc_address", wantErr: true},
+		{name: "mixed case bech32", value: "bc1qar0srrr7xfkvy5l643lydnw9re59gtzzwf5mDq", tag: "btc_address", wantErr: true},
+		{name: "testnet address on mainnet", value: "tb1qw508d6qejxtdg4y5r3zarvary0c5xw7kxpjzsx", tag: "btc_address", wantErr: true},
+		{name: "valid testnet segwit", value: "tb1qw508d6qejxtdg4y5r3zarvary0c5xw7kxpjzsx", tag: "btc_address=testnet", wantErr: false},
+		{name: "valid testnet legacy", value: "mipcBbFg9gMiCh81Kj8tqqdgoZub1ZJRfn", tag: "btc_address=testnet", wantErr: false},
+		{name: "mainnet address on testnet", value: "1BvBMSEYstWetqTFn5Au4m4GFg7xJaNVN2", tag: "btc_address=testnet", wantErr: true},
+		{name: "ethereum address", value: "0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed", tag: "btc_address", wantErr: true},
+		{name: "empty string", value: "", tag: "btc_address", wantErr: true},
+		{name: "non-string value", value: 1, tag: "btc_address", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := v.Var(tt.value, tt.tag)
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestValidateETHAddress(t *testing.T) {
+	v := validator.New()
+	RegisterPaymentValidators(v)
+
+	tests := []struct {
+		name    string
+		value   interface{}
+		tag     string
+		wantErr bool
+	}{
+		{name: "valid checksummed", value: "0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed", tag: "eth_address", wantErr: false},
+		{name: "valid checksummed second", value: "0xfB6916095ca1df60bB79Ce92cE3Ea74c37c5d359", tag: "eth_address", wantErr: false},
+		{name: "valid all lowercase", value: "0x5aaeb6053f3e94c9b9a09f33669435e7ef1beaed", tag: "eth_address", wantErr: false},
+		{name: "valid all uppercase", value: "0x5AAEB6053F3E94C9B9A09F33669435E7EF1BEAED", tag: "eth_address", wantErr: false},
+		{name: "bad mixed-case checksum", value: "0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAeD", tag: "eth_address", wantErr: true},
+		{name: "missing prefix", value: "5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed", tag: "eth_address", wantErr: true},
+		{name: "uppercase prefix", value: "0X5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed", tag: "eth_address", wantErr: true},
+		{name: "too short", value: "0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeA", tag: "eth_address", wantErr: true},
+		{name: "non-hex character", value: "0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAeg", tag: "eth_address", wantErr: true},
+		{name: "checksum required and valid", value: "0xdbF03B407c01E7cD3CBea99509d93f8DDDC8C6FB", tag: "eth_address=checksum", wantErr: false},
+		{name: "checksum required all lowercase", value: "0x5aaeb6053f3e94c9b9a09f33669435e7ef1beaed", tag: "eth_address=checksum", wantErr: true},
+		{name: "checksum required digits only", value: "0x0000000000000000000000000000000000000000", tag: "eth_address=checksum", wantErr: false},
+		{name: "empty string", value: "", tag: "eth_address", wantErr: true},
+		{name: "non-string value", value: 1, tag: "eth_address", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := v.Var(tt.value, tt.tag)
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestCryptoAddress_InvalidParam(t *testing.T) {
+	v, err := NewValidator()
+	require.NoError(t, err)
+
+	tests := []struct {
+		tag   string
+		value string
+	}{
+		{tag: "btc_address=regtest", value: "1BvBMSEYstWetqTFn5Au4m4GFg7xJaNVN2"},
+		{tag: "eth_address=strict", value: "0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.tag, func(t *testing.T) {
+			err := v.Var(tt.value, tt.tag)
+
+			var configErr *ConfigError
+			require.ErrorAs(t, err, &configErr)
+		})
+	}
+}
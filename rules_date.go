@@ -0,0 +1,314 @@
+package xvalidator
+
+import (
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-playground/validator/v10"
+)
+
+// defaultDateLayout is the layout used to parse date strings when a date rule has no layout parameter.
+const defaultDateLayout = "2006-01-02"
+
+// timeType is the reflect.Type of time.Time, used to accept time.Time fields in date rules.
+var timeType = reflect.TypeOf(time.Time{})
+
+// parseDateOffsetParam splits a "layout:offset" parameter of the future_date and past_date rules.
+// The offset is a Go duration after the last colon ("2006-01-02:24h"); a parameter that is a duration
+// alone sets only the offset. Layouts may contain colons ("15:04"), so a trailing segment that is not a
+// duration belongs to the layout. The returned offsetText is the offset as written, for messages.
+func parseDateOffsetParam(param string) (layout string, offset time.Duration, offsetText string) {
+	layout = param
+	if d, err := time.ParseDuration(param); err == nil {
+		layout, offset, offsetText = "", d, param
+	} else if i := strings.LastIndex(param, ":"); i >= 0 {
+		if d, err := time.ParseDuration(param[i+1:]); err == nil {
+			layout, offset, offsetText = param[:i], d, param[i+1:]
+		}
+	}
+
+	if layout == "" {
+		layout = defaultDateLayout
+	}
+	return layout, offset, offsetText
+}
+
+// fieldTime returns the time held by a time.Time field, or parsed from a string field with layout.
+func fieldTime(field reflect.Value, layout string) (time.Time, bool) {
+	switch {
+	case field.Type() == timeType:
+		return field.Interface().(time.Time), true
+	case field.Kind() == reflect.String:
+		t, err := time.Parse(layout, field.String())
+		return t, err == nil
+	default:
+		return time.Time{}, false
+	}
+}
+
+// validateFutureDate returns a validator checking that a date lies after the current time according to now,
+// plus an optional offset. String fields are parsed with the layout parameter (default "2006-01-02", in UTC
+// unless the layout has a zone); time.Time fields are compared directly.
+// Usage:
+//   - `validate:"future_date"` - e.g., "2026-10-17" when today is 2026-10-16
+//   - `validate:"future_date=02/01/2006"` - custom layout
+//   - `validate:"future_date=2006-01-02:24h"` - at least 24 hours from now
+//   - `validate:"future_date=1h"` - time.Time field at least one hour from now
+func validateFutureDate(now func() time.Time) validator.Func {
+	return func(fl validator.FieldLevel) bool {
+		layout, offset, _ := parseDateOffsetParam(fl.Param())
+
+		t, ok := fieldTime(fl.Field(), layout)
+		return ok && t.After(now().Add(offset))
+	}
+}
+
+// validatePastDate returns a validator checking that a date lies before the current time according to now,
+// minus an optional offset. Parameters and field types are the same as for future_date.
+// Usage:
+//   - `validate:"past_date"` - e.g., "2026-10-15" when today is 2026-10-16
+//   - `validate:"past_date=2006-01-02:720h"` - at least 30 days ago
+func validatePastDate(now func() time.Time) validator.Func {
+	return func(fl validator.FieldLevel) bool {
+		layout, offset, _ := parseDateOffsetParam(fl.Param())
+
+		t, ok := fieldTime(fl.Field(), layout)
+		return ok && t.Before(now().Add(-offset))
+	}
+}
+
+// ageOn returns the age in completed years on today of a person born on dob, comparing calendar dates.
+// People born on February 29 turn a year older on March 1 in non-leap years.
+func ageOn(dob, today time.Time) int {
+	age := today.Year() - dob.Year()
+	if today.Month() < dob.Month() || (today.Month() == dob.Month() && today.Day() < dob.Day()) {
+		age--
+	}
+	return age
+}
+
+// validateAgeGTE returns a validator checking that a date of birth makes the person at least the given number of
+// years old today according to now. String fields are parsed with the layout after the colon (default
+// "2006-01-02"); time.Time fields are used directly. Dates of birth in the future are invalid.
+// Usage:
+//   - `validate:"age_gte=18"` - e.g., "2008-10-16" on 2026-10-16
+//   - `validate:"age_gte=20:02/01/2006"` - custom layout, e.g., "16/10/2006"
+func validateAgeGTE(now func() time.Time) validator.Func {
+	return func(fl validator.FieldLevel) bool {
+		yearsText, layout, _ := strings.Cut(fl.Param(), ":")
+		years, err := strconv.Atoi(yearsText)
+		if err != nil || years < 0 {
+			panicConfigError(fl, "expected a minimum age in years, optionally followed by :layout")
+		}
+		if layout == "" {
+			layout = defaultDateLayout
+		}
+
+		dob, ok := fieldTime(fl.Field(), layout)
+		if !ok {
+			return false
+		}
+
+		today := now().In(dob.Location())
+		return !dob.After(today) && ageOn(dob, today) >= years
+	}
+}
+
+// Cross-field date validation logic functions
+
+// validateDateFieldOperation creates a validator comparing a date field with another date field using comparator.
+// The parameter names the other field, optionally followed by the layout used to parse string fields
+// (default "2006-01-02"): for example dtgtfield=StartDate or dtltefield=Period.End:2006-01-02T15:04:05Z07:00.
+// Fields may be strings or time.Time values; either being unparseable fails the validation.
+func validateDateFieldOperation(comparator func(t1, t2 time.Time) bool) validator.Func {
+	return func(fl validator.FieldLevel) bool {
+		name, layout, _ := strings.Cut(fl.Param(), ":")
+		if layout == "" {
+			layout = defaultDateLayout
+		}
+
+		otherField, found := lookupFieldPath(fl.Parent(), name)
+		if !found {
+			panicConfigError(fl, "date references a field that does not exist")
+		}
+		if !otherField.IsValid() {
+			return false
+		}
+
+		value, ok := fieldTime(fl.Field(), layout)
+		if !ok {
+			return false
+		}
+		otherValue, ok := fieldTime(otherField, layout)
+		if !ok {
+			return false
+		}
+
+		return comparator(value, otherValue)
+	}
+}
+
+// Date comparison functions
+
+// dateAfter compares if first time is after second.
+func dateAfter(t1, t2 time.Time) bool {
+	return t1.After(t2)
+}
+
+// dateAfterOrEqual compares if first time is after or equal to second.
+func dateAfterOrEqual(t1, t2 time.Time) bool {
+	return !t1.Before(t2)
+}
+
+// dateBefore compares if first time is before second.
+func dateBefore(t1, t2 time.Time) bool {
+	return t1.Before(t2)
+}
+
+// dateBeforeOrEqual compares if first time is before or equal to second.
+func dateBeforeOrEqual(t1, t2 time.Time) bool {
+	return !t1.After(t2)
+}
+
+// Time-of-day validation logic functions
+
+// timeBetweenParams holds the parsed form of a time_between rule parameter.
+// Bounds are seconds since midnight; location is nil when no time zone is given.
+type timeBetweenParams struct {
+	start, end int
+	location   *time.Location
+}
+
+// timeBetweenParamsCache caches parseTimeBetweenParam results keyed by the raw tag parameter,
+// so time zones are loaded once per tag.
+var timeBetweenParamsCache sync.Map
+
+// parseClock parses an "HH:MM" or "HH:MM:SS" time of day into seconds since midnight.
+func parseClock(value string) (int, bool) {
+	var t time.Time
+	var err error
+	if len(value) == len("15:04") {
+		t, err = time.Parse("15:04", value)
+	} else {
+		t, err = time.Parse("15:04:05", value)
+	}
+	if err != nil {
+		return 0, false
+	}
+	return t.Hour()*3600 + t.Minute()*60 + t.Second(), true
+}
+
+// parseTimeBetweenParam parses a time_between parameter: a window "HH:MM:HH:MM",
+// optionally followed by an IANA time zone name ("09:00:17:00 Asia/Bangkok").
+func parseTimeBetweenParam(param string) (timeBetweenParams, bool) {
+	if cached, ok := timeBetweenParamsCache.Load(param); ok {
+		return cached.(timeBetweenParams), true
+	}
+
+	fields := strings.Fields(param)
+	if len(fields) == 0 || len(fields) > 2 {
+		return timeBetweenParams{}, false
+	}
+
+	parts := strings.Split(fields[0], ":")
+	if len(parts) != 4 {
+		return timeBetweenParams{}, false
+	}
+	start, startOK := parseClock(parts[0] + ":" + parts[1])
+	end, endOK := parseClock(parts[2] + ":" + parts[3])
+	if !startOK || !endOK {
+		return timeBetweenParams{}, false
+	}
+
+	params := timeBetweenParams{start: start, end: end}
+	if len(fields) == 2 {
+		location, err := time.LoadLocation(fields[1])
+		if err != nil {
+			return timeBetweenParams{}, false
+		}
+		params.location = location
+	}
+
+	timeBetweenParamsCache.Store(param, params)
+	return params, true
+}
+
+// validateTimeBetween validates that a time of day falls within a window, bounds included.
+// String fields hold "HH:MM" or "HH:MM:SS"; time.Time fields use their clock time in the time zone
+// parameter, or in their own location without one. A window ending before it starts spans midnight.
+// Usage:
+//   - `validate:"time_between=09:00:17:00"` - business hours
+//   - `validate:"time_between=22:00:06:00"` - overnight window
+//   - `validate:"time_between=09:00:17:00 Asia/Bangkok"` - time.Time fields in Bangkok time
+func validateTimeBetween(fl validator.FieldLevel) bool {
+	params, ok := parseTimeBetweenParam(fl.Param())
+	if !ok {
+		panicConfigError(fl, "expected a window such as 09:00:17:00, optionally followed by a time zone")
+	}
+
+	var seconds int
+	field := fl.Field()
+	switch {
+	case field.Type() == timeType:
+		t := field.Interface().(time.Time)
+		if params.location != nil {
+			t = t.In(params.location)
+		}
+		seconds = t.Hour()*3600 + t.Minute()*60 + t.Second()
+	case field.Kind() == reflect.String:
+		if seconds, ok = parseClock(field.String()); !ok {
+			return false
+		}
+	default:
+		return false
+	}
+
+	if params.start <= params.end {
+		return seconds >= params.start && seconds <= params.end
+	}
+	return seconds >= params.start || seconds <= params.end
+}
+
+// Timestamp validation logic functions
+
+// isRFC3339 reports whether value is a strict RFC 3339 timestamp, such as "2026-10-16T09:30:00+07:00":
+// uppercase T and Z, two-digit fields within range, a real calendar date and an offset below 24 hours.
+// With utc, the offset must be Z.
+func isRFC3339(value string, utc bool) bool {
+	match := RFC3339Regex().FindStringSubmatch(value)
+	if match == nil {
+		return false
+	}
+	if utc && !strings.HasSuffix(value, "Z") {
+		return false
+	}
+	if match[1] != "" {
+		offsetHours, _ := strconv.Atoi(match[1])
+		offsetMinutes, _ := strconv.Atoi(match[2])
+		if offsetHours > 23 || offsetMinutes > 59 {
+			return false
+		}
+	}
+
+	// time.Parse checks the field ranges and the calendar date (e.g., rejects February 30)
+	_, err := time.Parse(time.RFC3339Nano, value)
+	return err == nil
+}
+
+// validateRFC3339Rule returns a validator for strict RFC 3339 timestamp strings, unlike decoding into time.Time,
+// which accepts some non-conforming inputs. With utc, the timestamp must use the Z offset.
+// Usage:
+//   - `validate:"rfc3339"` - e.g., "2026-10-16T09:30:00+07:00" or "2026-10-16T02:30:00.123Z"
+//   - `validate:"rfc3339_utc"` - e.g., "2026-10-16T02:30:00Z"
+func validateRFC3339Rule(utc bool) validator.Func {
+	return func(fl validator.FieldLevel) bool {
+		field := fl.Field()
+		if field.Kind() != reflect.String {
+			return false
+		}
+		return isRFC3339(field.String(), utc)
+	}
+}
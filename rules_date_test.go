@@ -0,0 +1,402 @@
+package xvalidator
+
+import (
+	"testing"
+	"time"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// dateTestNow is the fixed current time used by the date rule tests.
+var dateTestNow = time.Date(2026, time.October, 16, 10, 30, 0, 0, time.UTC)
+
+func TestParseDateOffsetParam(t *testing.T) {
+	tests := []struct {
+		param      string
+		layout     string
+		offset     time.Duration
+		offsetText string
+	}{
+		{"", "2006-01-02", 0, ""},
+		{"02/01/2006", "02/01/2006", 0, ""},
+		{"2006-01-02:24h", "2006-01-02", 24 * time.Hour, "24h"},
+		{"24h", "2006-01-02", 24 * time.Hour, "24h"},
+		{":1h30m", "2006-01-02", 90 * time.Minute, "1h30m"},
+		{"15:04", "15:04", 0, ""},
+		{"2006-01-02 15:04:-1h", "2006-01-02 15:04", -time.Hour, "-1h"},
+		{time.RFC3339, time.RFC3339, 0, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.param, func(t *testing.T) {
+			layout, offset, offsetText := parseDateOffsetParam(tt.param)
+			assert.Equal(t, tt.layout, layout)
+			assert.Equal(t, tt.offset, offset)
+			assert.Equal(t, tt.offsetText, offsetText)
+		})
+	}
+}
+
+func TestValidateFutureAndPastDate(t *testing.T) {
+	v, err := NewValidator(WithClock(func() time.Time { return dateTestNow }))
+	require.NoError(t, err)
+
+	tests := []struct {
+		name    string
+		value   any
+		tag     string
+		wantErr bool
+	}{
+		{"future date", "2026-10-17", "future_date", false},
+		{"today is not future", "2026-10-16", "future_date", true},
+		{"past date is not future", "2020-01-01", "future_date", true},
+		{"custom layout", "17/10/2026", "future_date=02/01/2006", false},
+		{"wrong layout", "2026-10-17", "future_date=02/01/2006", true},
+		{"not a date", "tomorrow", "future_date", true},
+		{"offset satisfied", "2026-10-18", "future_date=2006-01-02:24h", false},
+		{"offset not satisfied", "2026-10-17", "future_date=2006-01-02:24h", true},
+		{"layout with time", "2026-10-16 11:00", "future_date=2006-01-02 15:04", false},
+		{"layout with time and offset", "2026-10-16 11:00", "future_date=2006-01-02 15:04:1h", true},
+		{"time value", dateTestNow.Add(time.Minute), "future_date", false},
+		{"time value with offset", dateTestNow.Add(time.Minute), "future_date=1h", true},
+		{"non-date type", 20261017, "future_date", true},
+
+		{"past date", "2026-10-15", "past_date", false},
+		{"today midnight is past", "2026-10-16", "past_date", false},
+		{"future date is not past", "2026-10-17", "past_date", true},
+		{"past offset satisfied", "2026-09-01", "past_date=2006-01-02:720h", false},
+		{"past offset not satisfied", "2026-10-01", "past_date=2006-01-02:720h", true},
+		{"past time value", dateTestNow.Add(-time.Minute), "past_date", false},
+		{"negative offset allows grace period", dateTestNow.Add(time.Minute), "past_date=-5m", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := v.Var(tt.value, tt.tag)
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+
+	t.Run("struct with time pointer", func(t *testing.T) {
+		type Event struct {
+			StartsAt *time.Time `validate:"required,future_date"`
+		}
+
+		future := dateTestNow.Add(time.Hour)
+		past := dateTestNow.Add(-time.Hour)
+		assert.NoError(t, v.Struct(Event{StartsAt: &future}))
+		assert.Error(t, v.Struct(Event{StartsAt: &past}))
+	})
+}
+
+func TestRegisterDateValidators(t *testing.T) {
+	v := validator.New()
+	RegisterDateValidators(v)
+
+	assert.NoError(t, v.Var(time.Now().AddDate(0, 0, 2).Format("2006-01-02"), "future_date"))
+	assert.NoError(t, v.Var("2020-01-01", "past_date"))
+}
+
+func TestAgeOn(t *testing.T) {
+	date := func(year int, month time.Month, day int) time.Time {
+		return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+	}
+
+	tests := []struct {
+		name  string
+		dob   time.Time
+		today time.Time
+		age   int
+	}{
+		{"birthday today", date(2008, time.October, 16), date(2026, time.October, 16), 18},
+		{"day before birthday", date(2008, time.October, 17), date(2026, time.October, 16), 17},
+		{"month before birthday", date(2008, time.November, 1), date(2026, time.October, 16), 17},
+		{"leap day before March 1", date(2008, time.February, 29), date(2026, time.February, 28), 17},
+		{"leap day on March 1", date(2008, time.February, 29), date(2026, time.March, 1), 18},
+		{"leap day in leap year", date(2008, time.February, 29), date(2028, time.February, 29), 20},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.age, ageOn(tt.dob, tt.today))
+		})
+	}
+}
+
+func TestValidateAgeGTE(t *testing.T) {
+	v, err := NewValidator(WithClock(func() time.Time { return dateTestNow }))
+	require.NoError(t, err)
+
+	tests := []struct {
+		name    string
+		value   any
+		tag     string
+		wantErr bool
+	}{
+		{"exactly 18 today", "2008-10-16", "age_gte=18", false},
+		{"18 tomorrow", "2008-10-17", "age_gte=18", true},
+		{"well over 18", "1980-01-01", "age_gte=18", false},
+		{"custom layout", "16/10/2006", "age_gte=20:02/01/2006", false},
+		{"custom layout too young", "17/10/2006", "age_gte=20:02/01/2006", true},
+		{"wrong layout", "2006-10-16", "age_gte=20:02/01/2006", true},
+		{"zero minimum accepts today", "2026-10-16", "age_gte=0", false},
+		{"future date of birth", "2026-10-17", "age_gte=0", true},
+		{"not a date", "sixteen", "age_gte=18", true},
+		{"time value", time.Date(2008, time.October, 16, 0, 0, 0, 0, time.UTC), "age_gte=18", false},
+		{"time value too young", time.Date(2008, time.December, 1, 0, 0, 0, 0, time.UTC), "age_gte=18", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := v.Var(tt.value, tt.tag)
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+
+	t.Run("invalid parameter is a config error", func(t *testing.T) {
+		for _, tag := range []string{"age_gte", "age_gte=adult", "age_gte=-1"} {
+			err := v.Var("2000-01-01", tag)
+			var configErr *ConfigError
+			require.ErrorAs(t, err, &configErr, tag)
+			assert.Equal(t, "age_gte", configErr.Tag)
+		}
+	})
+}
+
+func TestDateComparators(t *testing.T) {
+	early := time.Date(2026, time.October, 16, 0, 0, 0, 0, time.UTC)
+	late := early.Add(time.Hour)
+
+	tests := []struct {
+		name       string
+		comparator func(t1, t2 time.Time) bool
+		t1, t2     time.Time
+		expected   bool
+	}{
+		{"dateAfter - true", dateAfter, late, early, true},
+		{"dateAfter - equal", dateAfter, early, early, false},
+		{"dateAfterOrEqual - equal", dateAfterOrEqual, early, early, true},
+		{"dateAfterOrEqual - false", dateAfterOrEqual, early, late, false},
+		{"dateBefore - true", dateBefore, early, late, true},
+		{"dateBefore - equal", dateBefore, early, early, false},
+		{"dateBeforeOrEqual - equal", dateBeforeOrEqual, early, early, true},
+		{"dateBeforeOrEqual - false", dateBeforeOrEqual, late, early, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, tt.comparator(tt.t1, tt.t2))
+		})
+	}
+}
+
+func TestValidateDateFieldOperation(t *testing.T) {
+	v, err := NewValidator()
+	require.NoError(t, err)
+
+	type Period struct {
+		StartDate string `json:"start_date"`
+		EndDate   string `json:"end_date" validate:"dtgtfield=StartDate"`
+	}
+
+	type InclusivePeriod struct {
+		StartDate string `json:"start_date" validate:"dtltefield=EndDate"`
+		EndDate   string `json:"end_date"`
+	}
+
+	type Shift struct {
+		Start string `validate:"dtltfield=End:2006-01-02 15:04"`
+		End   string `validate:"dtgtefield=Start:2006-01-02 15:04"`
+	}
+
+	type Booking struct {
+		Stay struct {
+			CheckIn time.Time
+		}
+		CheckOut time.Time `validate:"dtgtfield=Stay.CheckIn"`
+	}
+
+	checkIn := time.Date(2026, time.October, 16, 14, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name    string
+		input   any
+		wantErr bool
+	}{
+		{"end after start", Period{StartDate: "2026-10-16", EndDate: "2026-10-20"}, false},
+		{"end equal to start", Period{StartDate: "2026-10-16", EndDate: "2026-10-16"}, true},
+		{"end before start", Period{StartDate: "2026-10-16", EndDate: "2026-10-01"}, true},
+		{"unparseable end", Period{StartDate: "2026-10-16", EndDate: "soon"}, true},
+		{"unparseable start", Period{StartDate: "", EndDate: "2026-10-20"}, true},
+		{"inclusive equal", InclusivePeriod{StartDate: "2026-10-16", EndDate: "2026-10-16"}, false},
+		{"inclusive after", InclusivePeriod{StartDate: "2026-10-17", EndDate: "2026-10-16"}, true},
+		{"datetime layout", Shift{Start: "2026-10-16 09:00", End: "2026-10-16 17:30"}, false},
+		{"datetime layout reversed", Shift{Start: "2026-10-16 17:30", End: "2026-10-16 09:00"}, true},
+		{"time values with nested path", func() Booking {
+			b := Booking{CheckOut: checkIn.Add(24 * time.Hour)}
+			b.Stay.CheckIn = checkIn
+			return b
+		}(), false},
+		{"time values reversed", func() Booking {
+			b := Booking{CheckOut: checkIn.Add(-time.Hour)}
+			b.Stay.CheckIn = checkIn
+			return b
+		}(), true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := v.Struct(tt.input)
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+
+	t.Run("translated message", func(t *testing.T) {
+		err := v.StructTranslated(Period{StartDate: "2026-10-16", EndDate: "2026-10-01"})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "end_date must be after StartDate")
+
+		err = v.StructTranslated(Shift{Start: "2026-10-16 17:30", End: "2026-10-16 09:00"})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "Start must be before End")
+		assert.Contains(t, err.Error(), "End must be on or after Start")
+	})
+
+	t.Run("unknown field is a config error", func(t *testing.T) {
+		type Misconfigured struct {
+			EndDate string `validate:"dtgtfield=Begin"`
+		}
+
+		err := v.Struct(Misconfigured{EndDate: "2026-10-20"})
+		var configErr *ConfigError
+		require.ErrorAs(t, err, &configErr)
+		assert.Equal(t, "dtgtfield", configErr.Tag)
+	})
+}
+
+func TestValidateTimeBetween(t *testing.T) {
+	v := validator.New()
+	RegisterDateValidators(v)
+
+	bangkok, err := time.LoadLocation("Asia/Bangkok")
+	require.NoError(t, err)
+
+	tests := []struct {
+		name    string
+		value   any
+		tag     string
+		wantErr bool
+	}{
+		{"inside window", "12:30", "time_between=09:00:17:00", false},
+		{"start bound included", "09:00", "time_between=09:00:17:00", false},
+		{"end bound included", "17:00", "time_between=09:00:17:00", false},
+		{"before window", "08:59", "time_between=09:00:17:00", true},
+		{"after window", "17:01", "time_between=09:00:17:00", true},
+		{"seconds after end", "17:00:01", "time_between=09:00:17:00", true},
+		{"seconds inside", "16:59:59", "time_between=09:00:17:00", false},
+		{"overnight late", "23:15", "time_between=22:00:06:00", false},
+		{"overnight early", "05:45", "time_between=22:00:06:00", false},
+		{"overnight outside", "12:00", "time_between=22:00:06:00", true},
+		{"invalid time", "25:00", "time_between=09:00:17:00", true},
+		{"not a time", "noon", "time_between=09:00:17:00", true},
+		{"non-time type", 1230, "time_between=09:00:17:00", true},
+		{"time value in own location", time.Date(2026, 10, 16, 10, 0, 0, 0, time.UTC), "time_between=09:00:17:00", false},
+		{"time value converted to zone", time.Date(2026, 10, 16, 10, 30, 0, 0, time.UTC), "time_between=09:00:17:00 Asia/Bangkok", true},
+		{"time value in zone", time.Date(2026, 10, 16, 3, 0, 0, 0, time.UTC), "time_between=09:00:17:00 Asia/Bangkok", false},
+		{"time value already in zone", time.Date(2026, 10, 16, 16, 0, 0, 0, bangkok), "time_between=09:00:17:00 Asia/Bangkok", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := v.Var(tt.value, tt.tag)
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+
+	t.Run("invalid parameter is a config error", func(t *testing.T) {
+		xv, err := NewValidator()
+		require.NoError(t, err)
+
+		for _, tag := range []string{"time_between", "time_between=09:00", "time_between=09:00:25:00", "time_between=09:00:17:00 Mars/Olympus"} {
+			err := xv.Var("12:00", tag)
+			var configErr *ConfigError
+			require.ErrorAs(t, err, &configErr, tag)
+			assert.Equal(t, "time_between", configErr.Tag)
+		}
+	})
+}
+
+func TestValidateRFC3339(t *testing.T) {
+	v := validator.New()
+	RegisterDateValidators(v)
+
+	tests := []struct {
+		name  string
+		value string
+		valid bool
+		utc   bool
+	}{
+		{"utc", "2026-10-16T02:30:00Z", true, true},
+		{"fractional seconds", "2026-10-16T02:30:00.123456789Z", true, true},
+		{"positive offset", "2026-10-16T09:30:00+07:00", true, false},
+		{"negative offset", "2026-10-15T22:30:00-04:00", true, false},
+		{"zero offset is not Z", "2026-10-16T02:30:00+00:00", true, false},
+		{"leap day", "2028-02-29T00:00:00Z", true, true},
+		{"lowercase t", "2026-10-16t02:30:00Z", false, false},
+		{"lowercase z", "2026-10-16T02:30:00z", false, false},
+		{"space separator", "2026-10-16 02:30:00Z", false, false},
+		{"missing offset", "2026-10-16T02:30:00", false, false},
+		{"missing seconds", "2026-10-16T02:30Z", false, false},
+		{"compact offset", "2026-10-16T09:30:00+0700", false, false},
+		{"empty fraction", "2026-10-16T02:30:00.Z", false, false},
+		{"date only", "2026-10-16", false, false},
+		{"invalid day", "2026-02-30T00:00:00Z", false, false},
+		{"invalid month", "2026-13-01T00:00:00Z", false, false},
+		{"invalid hour", "2026-10-16T24:00:00Z", false, false},
+		{"invalid minute", "2026-10-16T23:60:00Z", false, false},
+		{"offset hours out of range", "2026-10-16T09:30:00+24:00", false, false},
+		{"offset minutes out of range", "2026-10-16T09:30:00+07:60", false, false},
+		{"short year", "26-10-16T02:30:00Z", false, false},
+		{"unicode digits", "２026-10-16T02:30:00Z", false, false},
+		{"empty", "", false, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := v.Var(tt.value, "rfc3339")
+			if tt.valid {
+				assert.NoError(t, err)
+			} else {
+				assert.Error(t, err)
+			}
+
+			err = v.Var(tt.value, "rfc3339_utc")
+			if tt.utc {
+				assert.NoError(t, err)
+			} else {
+				assert.Error(t, err)
+			}
+		})
+	}
+
+	t.Run("non-string type", func(t *testing.T) {
+		assert.Error(t, v.Var(time.Now(), "rfc3339"))
+	})
+}
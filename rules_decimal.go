@@ -0,0 +1,465 @@
+package xvalidator
+
+import (
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/shopspring/decimal"
+)
+
+// Decimal range validation logic functions
+
+// parseDecimalRangeParam parses a decimal range parameter in "min:max" format.
+// Examples:
+//   - "0:1000000" -> min=0, max=1000000
+//   - "-10.5:10.5" -> min=-10.5, max=10.5
+//
+// Returns ok=false when the parameter is malformed or min is greater than max.
+func parseDecimalRangeParam(param string) (minValue, maxValue decimal.Decimal, ok bool) {
+	parts := strings.Split(param, ":")
+	if len(parts) != 2 {
+		return decimal.Decimal{}, decimal.Decimal{}, false
+	}
+
+	minValue, err := decimal.NewFromString(parts[0])
+	if err != nil {
+		return decimal.Decimal{}, decimal.Decimal{}, false
+	}
+
+	maxValue, err = decimal.NewFromString(parts[1])
+	if err != nil {
+		return decimal.Decimal{}, decimal.Decimal{}, false
+	}
+
+	if minValue.GreaterThan(maxValue) {
+		return decimal.Decimal{}, decimal.Decimal{}, false
+	}
+
+	return minValue, maxValue, true
+}
+
+// validateDecimalBetween validates that a decimal value falls within an inclusive range.
+// Supports formats:
+//   - dbetween=0:1000000 -> 0 <= value <= 1000000
+//   - dbetween=-1.5:1.5 -> -1.5 <= value <= 1.5
+//
+// A malformed range, or one whose min is greater than max, panics with *ConfigError.
+func validateDecimalBetween(fl validator.FieldLevel) bool {
+	minValue, maxValue, ok := parseDecimalRangeParam(fl.Param())
+	if !ok {
+		panicConfigError(fl, "expected min:max")
+	}
+
+	value, ok := decimalFieldValue(fl.Field())
+	if !ok {
+		return false
+	}
+
+	return value.GreaterThanOrEqual(minValue) && value.LessThanOrEqual(maxValue)
+}
+
+// Cross-field decimal validation logic functions
+
+// validateDecimalFieldOperation creates a validator function comparing a decimal field with another field.
+// The parameter names the other field, for example dltefield=RegularPrice or dgtfield=Limits.Min.
+// Both fields accept the same inputs as the literal comparison rules.
+// A reference to a nonexistent field panics with *ConfigError.
+func validateDecimalFieldOperation(comparator func(d1, d2 *decimal.Decimal) bool) validator.Func {
+	return func(fl validator.FieldLevel) bool {
+		// Resolve the referenced field from the parent struct
+		otherField, found := lookupFieldPath(fl.Parent(), fl.Param())
+		if !found {
+			panicConfigError(fl, "comparison references a field that does not exist")
+		}
+
+		value, ok := decimalFieldValue(fl.Field())
+		if !ok {
+			return false
+		}
+
+		otherValue, ok := decimalFieldValue(otherField)
+		if !ok {
+			return false
+		}
+
+		return comparator(&value, &otherValue)
+	}
+}
+
+// Decimal sum reconciliation logic functions
+
+// decimalSumTerm represents one operand of a dsum expression.
+// Either field holds a field name (dotted paths allowed) or constant holds a literal decimal.
+type decimalSumTerm struct {
+	field    string
+	constant decimal.Decimal
+	negative bool
+}
+
+// parseDecimalSumParam parses a dsum expression into terms.
+// Expression format: operands separated by + or -, where each operand is a field name or a decimal literal.
+// Examples:
+//   - "Subtotal+Tax" -> Subtotal + Tax
+//   - "Subtotal+Tax+ShippingFee-Discount" -> Subtotal + Tax + ShippingFee - Discount
+//   - "Items.Total+5.00" -> Items.Total + 5.00
+//   - "Subtotal-2.5E-3" -> Subtotal - 0.0025 (a sign after the exponent marker of a numeric operand is not an operator)
+//
+// Returns ok=false when the expression is empty or contains an empty operand.
+func parseDecimalSumParam(param string) (terms []decimalSumTerm, ok bool) {
+	param = strings.TrimSpace(param)
+	if param == "" {
+		return nil, false
+	}
+
+	negative := false
+	start := 0
+	if param[0] == '-' || param[0] == '+' {
+		negative = param[0] == '-'
+		start = 1
+	}
+
+	for i := start; i <= len(param); i++ {
+		if i < len(param) && param[i] != '+' && param[i] != '-' {
+			continue
+		}
+		if i < len(param) && isDecimalExponentSign(param[start:i]) {
+			continue
+		}
+
+		operand := strings.TrimSpace(param[start:i])
+		if operand == "" {
+			return nil, false
+		}
+
+		term := decimalSumTerm{negative: negative}
+		if constant, err := decimal.NewFromString(operand); err == nil {
+			term.constant = constant
+		} else {
+			term.field = operand
+		}
+		terms = append(terms, term)
+
+		if i < len(param) {
+			negative = param[i] == '-'
+		}
+		start = i + 1
+	}
+
+	return terms, true
+}
+
+// isDecimalExponentSign reports whether a sign following operand belongs to its exponent, as in 1e+5:
+// operand is a decimal mantissa (digits with at most one ".") followed by "e" or "E".
+func isDecimalExponentSign(operand string) bool {
+	operand = strings.TrimSpace(operand)
+	if len(operand) < 2 || (operand[len(operand)-1] != 'e' && operand[len(operand)-1] != 'E') {
+		return false
+	}
+
+	digits, dots := 0, 0
+	for _, c := range operand[:len(operand)-1] {
+		switch {
+		case c >= '0' && c <= '9':
+			digits++
+		case c == '.':
+			dots++
+		default:
+			return false
+		}
+	}
+	return digits > 0 && dots <= 1
+}
+
+// validateDecimalSum validates that a decimal field equals an arithmetic combination of sibling fields.
+// Supports formats:
+//   - dsum=Subtotal+Tax -> value == Subtotal + Tax
+//   - dsum=Subtotal+Tax+ShippingFee-Discount -> value == Subtotal + Tax + ShippingFee - Discount
+//
+// Referenced fields accept the same inputs as the comparison rules; an invalid or nil operand fails validation.
+// A malformed expression or an operand naming a nonexistent field panics with *ConfigError.
+func validateDecimalSum(fl validator.FieldLevel) bool {
+	terms, ok := parseDecimalSumParam(fl.Param())
+	if !ok {
+		panicConfigError(fl, "expected fields or decimals joined by + or -")
+	}
+
+	value, ok := decimalFieldValue(fl.Field())
+	if !ok {
+		return false
+	}
+
+	sum := decimal.Zero
+	for _, term := range terms {
+		operand := term.constant
+		if term.field != "" {
+			field, found := lookupFieldPath(fl.Parent(), term.field)
+			if !found {
+				panicConfigError(fl, "operand references a field that does not exist")
+			}
+
+			operand, ok = decimalFieldValue(field)
+			if !ok {
+				return false
+			}
+		}
+
+		if term.negative {
+			sum = sum.Sub(operand)
+		} else {
+			sum = sum.Add(operand)
+		}
+	}
+
+	return value.Equal(sum)
+}
+
+// Currency-aware decimal validation logic functions
+
+// validateDecimalCurrency validates decimal scale according to the ISO 4217 minor units of a sibling currency field.
+// Parameter format: the name of the currency field.
+// Examples:
+//   - decimal_currency=Currency with Currency "THB" -> at most 2 decimal places
+//   - decimal_currency=Currency with Currency "JPY" -> integer only
+//   - decimal_currency=Currency with Currency "BHD" -> at most 3 decimal places
+//
+// Unknown currency codes and nil currency fields fail validation. A reference to a nonexistent field or to a
+// field that is not a string panics with *ConfigError.
+func validateDecimalCurrency(fl validator.FieldLevel) bool {
+	// Resolve the currency code from the referenced field
+	currencyField, found := lookupFieldPath(fl.Parent(), fl.Param())
+	if !found {
+		panicConfigError(fl, "currency references a field that does not exist")
+	}
+	if currencyField.IsValid() && currencyField.Kind() != reflect.String {
+		panicConfigError(fl, "currency field must be a string")
+	}
+
+	data, ok := decimalFieldString(fl.Field())
+	if !ok || !currencyField.IsValid() {
+		return false
+	}
+
+	scale, ok := CurrencyMinorUnits(currencyField.String())
+	if !ok {
+		return false
+	}
+
+	return validateDecimalString(data, DefaultPrecision, scale)
+}
+
+// Decimal step validation logic functions
+
+// validateDecimalMultipleOf validates that a decimal value is an exact multiple of the given step.
+// The remainder is computed with decimal.Mod so no floating-point drift is introduced.
+// Supports formats:
+//   - dmultipleof=0.25 -> 1.75 is valid, 1.80 is not
+//   - dmultipleof=0.05 -> cash rounding to 5 satang
+//
+// A zero, negative, or malformed step panics with *ConfigError.
+func validateDecimalMultipleOf(fl validator.FieldLevel) bool {
+	step, err := decimal.NewFromString(fl.Param())
+	if err != nil || !step.IsPositive() {
+		panicConfigError(fl, "expected a positive decimal step")
+	}
+
+	value, ok := decimalFieldValue(fl.Field())
+	if !ok {
+		return false
+	}
+
+	return value.Mod(step).IsZero()
+}
+
+// Decimal sign validation logic functions
+
+// validateDecimalSign creates a validator function checking the sign of a decimal value.
+// It accepts the same inputs as the comparison rules and takes no parameter.
+func validateDecimalSign(check func(d *decimal.Decimal) bool) validator.Func {
+	return func(fl validator.FieldLevel) bool {
+		value, ok := decimalFieldValue(fl.Field())
+		if !ok {
+			return false
+		}
+		return check(&value)
+	}
+}
+
+// decimalPositive checks if a decimal is greater than zero.
+func decimalPositive(d *decimal.Decimal) bool {
+	return d.IsPositive()
+}
+
+// decimalNegative checks if a decimal is less than zero.
+func decimalNegative(d *decimal.Decimal) bool {
+	return d.IsNegative()
+}
+
+// decimalNonZero checks if a decimal is not zero.
+func decimalNonZero(d *decimal.Decimal) bool {
+	return !d.IsZero()
+}
+
+// Significant digits validation logic functions
+
+// decimalSignificantDigits returns the number of significant digits needed to represent a decimal value.
+// Leading zeros and trailing zeros are not significant, so "0.001230" and "1230" both have 3.
+// Zero has no significant digits.
+func decimalSignificantDigits(value decimal.Decimal) int {
+	digits := strings.TrimLeft(value.Coefficient().String(), "-")
+	digits = strings.TrimRight(digits, "0")
+	return len(digits)
+}
+
+// validateDecimalSignificantDigits validates that a decimal value has at most N significant digits,
+// independently of its scale.
+// Supports formats:
+//   - dsigits=6 -> "35.1234" and "0.000012345" are valid, "35.12345" is not
+//
+// A non-numeric or non-positive limit panics with *ConfigError.
+func validateDecimalSignificantDigits(fl validator.FieldLevel) bool {
+	limit, err := strconv.Atoi(fl.Param())
+	if err != nil || limit < 1 {
+		panicConfigError(fl, "expected a positive number of significant digits")
+	}
+
+	value, ok := decimalFieldValue(fl.Field())
+	if !ok {
+		return false
+	}
+
+	return decimalSignificantDigits(value) <= limit
+}
+
+// Canonical decimal form validation logic functions
+
+// isCanonicalDecimal reports whether s is a decimal string in normalized form.
+// Canonical form means an optional "-" sign, an integer part without leading zeros,
+// and an optional fractional part; exponent notation, a leading "+", whitespace,
+// missing integer or fractional digits, and negative zero are rejected.
+// When allowTrailingZeros is false, the fractional part must not end in "0".
+func isCanonicalDecimal(s string, allowTrailingZeros bool) bool {
+	negative := strings.HasPrefix(s, "-")
+	if negative {
+		s = s[1:]
+	}
+
+	integerPart, fractionPart, hasDot := strings.Cut(s, ".")
+	if integerPart == "" || (hasDot && fractionPart == "") {
+		return false
+	}
+	if len(integerPart) > 1 && integerPart[0] == '0' {
+		return false
+	}
+
+	allZero := true
+	for _, part := range []string{integerPart, fractionPart} {
+		for i := 0; i < len(part); i++ {
+			if part[i] < '0' || part[i] > '9' {
+				return false
+			}
+			if part[i] != '0' {
+				allZero = false
+			}
+		}
+	}
+
+	if negative && allZero {
+		return false
+	}
+
+	if !allowTrailingZeros && strings.HasSuffix(fractionPart, "0") {
+		return false
+	}
+
+	return true
+}
+
+// validateDecimalCanonical validates that a decimal string is stored in canonical form.
+// Supports formats:
+//   - decimal_canonical -> rejects exponents, leading zeros, and a leading "+" ("100.50" is valid)
+//   - decimal_canonical=notrailing -> also rejects trailing fractional zeros ("100.5" is valid, "100.50" is not)
+//
+// Any other parameter panics with *ConfigError.
+func validateDecimalCanonical(fl validator.FieldLevel) bool {
+	allowTrailingZeros := true
+	switch fl.Param() {
+	case "":
+	case "notrailing":
+		allowTrailingZeros = false
+	default:
+		panicConfigError(fl, "expected no parameter or notrailing")
+	}
+
+	data, ok := decimalFieldString(fl.Field())
+	if !ok {
+		return false
+	}
+
+	return isCanonicalDecimal(data, allowTrailingZeros)
+}
+
+// Loose decimal parsing logic functions
+
+// NormalizeLooseDecimal converts a user-formatted decimal string into a plain decimal string.
+// Surrounding whitespace is trimmed and comma grouping separators are removed, so "1,234.50 " becomes "1234.50".
+// Grouping must use groups of three digits in the integer part; returns false when it does not
+// or when the result is not a valid decimal.
+func NormalizeLooseDecimal(s string) (string, bool) {
+	s = strings.TrimSpace(s)
+
+	integerPart, fractionPart, hasDot := strings.Cut(s, ".")
+	if strings.Contains(fractionPart, ",") {
+		return "", false
+	}
+
+	if strings.Contains(integerPart, ",") {
+		sign := ""
+		if strings.HasPrefix(integerPart, "-") || strings.HasPrefix(integerPart, "+") {
+			sign, integerPart = integerPart[:1], integerPart[1:]
+		}
+
+		groups := strings.Split(integerPart, ",")
+		if len(groups[0]) < 1 || len(groups[0]) > 3 {
+			return "", false
+		}
+		for _, group := range groups[1:] {
+			if len(group) != 3 {
+				return "", false
+			}
+		}
+		integerPart = sign + strings.Join(groups, "")
+	}
+
+	normalized := integerPart
+	if hasDot {
+		normalized += "." + fractionPart
+	}
+
+	if _, err := decimal.NewFromString(normalized); err != nil {
+		return "", false
+	}
+
+	return normalized, true
+}
+
+// validateDecimalLoose validates decimal precision and scale after normalizing grouping separators and whitespace.
+// It accepts the same parameters as the decimal rule.
+// Supports formats:
+//   - decimal_loose -> "1,234.50 " is validated as "1234.50" with default precision and scale
+//   - decimal_loose=10:2 -> "1,234,567.89" is valid, "1,234.567" is not
+func validateDecimalLoose(fl validator.FieldLevel) bool {
+	data, ok := decimalFieldString(fl.Field())
+	if !ok {
+		return false
+	}
+
+	normalized, ok := NormalizeLooseDecimal(data)
+	if !ok {
+		return false
+	}
+
+	precision, scale := cachedDecimalParams(fl.Param())
+	return validateDecimalString(normalized, precision, scale)
+}
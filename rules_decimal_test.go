@@ -1,12 +1,16 @@
 package xvalidator
 
 import (
+	"encoding/json"
+	"math"
 	"reflect"
+	"strings"
 	"testing"
 
 	"github.com/go-playground/validator/v10"
 	"github.com/shopspring/decimal"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestDecimalComparatorFunctions(t *testing.T) {
@@ -413,6 +417,7 @@ func TestParseDecimalIfParam(t *testing.T) {
 		expectedRule   string
 		expectedField  string
 		expectedExpect string
+		expectedGroups int
 		expectError    bool
 	}{
 		// Valid cases
@@ -456,6 +461,24 @@ func TestParseDecimalIfParam(t *testing.T) {
 			expectedExpect: "credit_card",
 			expectError:    false,
 		},
+		{
+			name:           "AND conditions - 2@Type=credit&Region=TH",
+			param:          "2@Type=credit&Region=TH",
+			expectedRule:   "2",
+			expectedField:  "Type",
+			expectedExpect: "credit",
+			expectedGroups: 1,
+			expectError:    false,
+		},
+		{
+			name:           "OR conditions - 2@Type=credit|Type=debit",
+			param:          "2@Type=credit|Type=debit",
+			expectedRule:   "2",
+			expectedField:  "Type",
+			expectedExpect: "credit",
+			expectedGroups: 2,
+			expectError:    false,
+		},
 
 		// Invalid cases
 		{
@@ -493,6 +516,11 @@ func TestParseDecimalIfParam(t *testing.T) {
 			param:       "2@Mode=mode=1",
 			expectError: true,
 		},
+		{
+			name:        "dangling AND - 2@Mode=mode1&",
+			param:       "2@Mode=mode1&",
+			expectError: true,
+		},
 		{
 			name:           "missing field name - 2@=mode1",
 			param:          "2@=mode1",
@@ -513,15 +541,20 @@ func TestParseDecimalIfParam(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			rule, field, expect, err := parseDecimalIfParam(tt.param)
+			rule, conditions, err := parseDecimalIfParam(tt.param)
 
 			if tt.expectError {
 				assert.Error(t, err)
 			} else {
 				assert.NoError(t, err)
 				assert.Equal(t, tt.expectedRule, rule)
-				assert.Equal(t, tt.expectedField, field)
-				assert.Equal(t, tt.expectedExpect, expect)
+				require.NotEmpty(t, conditions)
+				require.NotEmpty(t, conditions[0])
+				assert.Equal(t, tt.expectedField, conditions[0][0].field)
+				assert.Equal(t, tt.expectedExpect, conditions[0][0].expect)
+				if tt.expectedGroups > 0 {
+					assert.Len(t, conditions, tt.expectedGroups)
+				}
 			}
 		})
 	}
@@ -724,3 +757,1179 @@ func TestValidateDecimalIf(t *testing.T) {
 		})
 	}
 }
+
+func TestDecimalValidatorsWithJSONNumber(t *testing.T) {
+	v := validator.New()
+	RegisterDecimalValidators(v)
+
+	type Payload struct {
+		Mode   string      `json:"mode"`
+		Amount json.Number `json:"amount" validate:"decimal=10:2,dgt=0,dlte=1000"`
+		Fee    json.Number `json:"fee" validate:"decimal_if=0@Mode=integer"`
+	}
+
+	tests := []struct {
+		name    string
+		body    string
+		wantErr bool
+	}{
+		{"valid decoded numbers", `{"mode":"integer","amount":123.45,"fee":10}`, false},
+		{"scale exceeded", `{"mode":"integer","amount":123.456,"fee":10}`, true},
+		{"comparison failed", `{"mode":"integer","amount":1000.01,"fee":10}`, true},
+		{"conditional rule applied", `{"mode":"integer","amount":1,"fee":10.5}`, true},
+		{"conditional rule skipped", `{"mode":"decimal","amount":1,"fee":10.5}`, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var payload Payload
+			decoder := json.NewDecoder(strings.NewReader(tt.body))
+			decoder.UseNumber()
+			require.NoError(t, decoder.Decode(&payload))
+
+			err := v.Struct(payload)
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestDecimalComparisonWithNumericFields(t *testing.T) {
+	v := validator.New()
+	RegisterDecimalValidators(v)
+
+	type Product struct {
+		Price    decimal.Decimal  `validate:"dgt=0"`
+		Discount *decimal.Decimal `validate:"omitempty,dlte=100"`
+		Weight   float64          `validate:"dgte=0.5"`
+		Stock    int              `validate:"dgte=0"`
+		Limit    uint32           `validate:"dlt=1000"`
+	}
+
+	discount := decimal.RequireFromString("10.5")
+	tooLarge := decimal.RequireFromString("100.01")
+
+	tests := []struct {
+		name    string
+		input   Product
+		wantErr bool
+		field   string
+	}{
+		{
+			name:    "all valid",
+			input:   Product{Price: decimal.RequireFromString("99.99"), Discount: &discount, Weight: 0.5, Stock: 0, Limit: 999},
+			wantErr: false,
+		},
+		{
+			name:    "nil pointer skipped by omitempty",
+			input:   Product{Price: decimal.RequireFromString("1"), Weight: 1, Stock: 1, Limit: 1},
+			wantErr: false,
+		},
+		{
+			name:    "decimal.Decimal fails",
+			input:   Product{Price: decimal.Zero, Weight: 1, Stock: 1, Limit: 1},
+			wantErr: true,
+			field:   "Price",
+		},
+		{
+			name:    "decimal pointer fails",
+			input:   Product{Price: decimal.RequireFromString("1"), Discount: &tooLarge, Weight: 1, Stock: 1, Limit: 1},
+			wantErr: true,
+			field:   "Discount",
+		},
+		{
+			name:    "float fails",
+			input:   Product{Price: decimal.RequireFromString("1"), Weight: 0.49, Stock: 1, Limit: 1},
+			wantErr: true,
+			field:   "Weight",
+		},
+		{
+			name:    "int fails",
+			input:   Product{Price: decimal.RequireFromString("1"), Weight: 1, Stock: -1, Limit: 1},
+			wantErr: true,
+			field:   "Stock",
+		},
+		{
+			name:    "uint fails",
+			input:   Product{Price: decimal.RequireFromString("1"), Weight: 1, Stock: 1, Limit: 1000},
+			wantErr: true,
+			field:   "Limit",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := v.Struct(tt.input)
+			if !tt.wantErr {
+				assert.NoError(t, err)
+				return
+			}
+
+			require.Error(t, err)
+			validationErrors, ok := err.(validator.ValidationErrors)
+			require.True(t, ok)
+			require.Len(t, validationErrors, 1)
+			assert.Equal(t, tt.field, validationErrors[0].Field())
+		})
+	}
+}
+
+func TestDecimalFieldValue(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    any
+		expected string
+		ok       bool
+	}{
+		{"string", "12.50", "12.5", true},
+		{"json.Number", json.Number("7"), "7", true},
+		{"int", -3, "-3", true},
+		{"uint", uint(3), "3", true},
+		{"float", 1.25, "1.25", true},
+		{"decimal.Decimal", decimal.RequireFromString("4.2"), "4.2", true},
+		{"invalid string", "abc", "", false},
+		{"bool", true, "", false},
+		{"NaN float", math.NaN(), "", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			value, ok := decimalFieldValue(reflect.ValueOf(tt.input))
+			assert.Equal(t, tt.ok, ok)
+			if tt.ok {
+				assert.Equal(t, tt.expected, value.String())
+			}
+		})
+	}
+}
+
+func TestParseDecimalRangeParam(t *testing.T) {
+	tests := []struct {
+		name        string
+		param       string
+		expectedMin string
+		expectedMax string
+		ok          bool
+	}{
+		{"integer range", "0:1000000", "0", "1000000", true},
+		{"negative range", "-10.5:10.5", "-10.5", "10.5", true},
+		{"single value range", "5:5", "5", "5", true},
+		{"min greater than max", "10:1", "", "", false},
+		{"missing separator", "10", "", "", false},
+		{"too many parts", "1:2:3", "", "", false},
+		{"invalid min", "abc:10", "", "", false},
+		{"invalid max", "0:abc", "", "", false},
+		{"empty param", "", "", "", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			minValue, maxValue, ok := parseDecimalRangeParam(tt.param)
+			assert.Equal(t, tt.ok, ok)
+			if tt.ok {
+				assert.Equal(t, tt.expectedMin, minValue.String())
+				assert.Equal(t, tt.expectedMax, maxValue.String())
+			}
+		})
+	}
+}
+
+func TestValidateDecimalBetween(t *testing.T) {
+	v := validator.New()
+	RegisterDecimalValidators(v)
+
+	tests := []struct {
+		name    string
+		value   any
+		tag     string
+		wantErr bool
+	}{
+		{"lower bound inclusive", "0", "dbetween=0:1000000", false},
+		{"upper bound inclusive", "1000000.00", "dbetween=0:1000000", false},
+		{"inside range", "500.25", "dbetween=0:1000000", false},
+		{"below range", "-0.01", "dbetween=0:1000000", true},
+		{"above range", "1000000.01", "dbetween=0:1000000", true},
+		{"negative range", "-1.5", "dbetween=-1.5:1.5", false},
+		{"integer field", 42, "dbetween=1:100", false},
+		{"decimal field", decimal.RequireFromString("100.5"), "dbetween=1:100", true},
+		{"invalid value", "abc", "dbetween=0:10", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := v.Var(tt.value, tt.tag)
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+
+	t.Run("malformed range is a config error", func(t *testing.T) {
+		xv, err := NewValidator()
+		require.NoError(t, err)
+
+		for _, tag := range []string{"dbetween=10:0", "dbetween=abc", "dbetween=1:x", "dbetween="} {
+			var configErr *ConfigError
+			require.ErrorAs(t, xv.Var("5", tag), &configErr, tag)
+			assert.Equal(t, "dbetween", configErr.Tag)
+		}
+	})
+}
+
+func TestValidateDecimalFieldOperation(t *testing.T) {
+	v := validator.New()
+	RegisterDecimalValidators(v)
+
+	type Limits struct {
+		Min string
+	}
+
+	type Pricing struct {
+		RegularPrice string
+		SalePrice    string `validate:"dltefield=RegularPrice"`
+		Cost         decimal.Decimal
+		Margin       string `validate:"dgtfield=Cost"`
+		Limits       Limits
+		Quantity     int    `validate:"dgtefield=Limits.Min"`
+		Deposit      string `validate:"dltfield=RegularPrice"`
+		Total        string `validate:"deqfield=RegularPrice"`
+	}
+
+	valid := Pricing{
+		RegularPrice: "100.00",
+		SalePrice:    "100",
+		Cost:         decimal.RequireFromString("10"),
+		Margin:       "10.01",
+		Limits:       Limits{Min: "1"},
+		Quantity:     1,
+		Deposit:      "99.99",
+		Total:        "100.0",
+	}
+
+	tests := []struct {
+		name    string
+		modify  func(p *Pricing)
+		wantErr bool
+		field   string
+	}{
+		{"all valid", func(p *Pricing) {}, false, ""},
+		{"sale price above regular", func(p *Pricing) { p.SalePrice = "100.01" }, true, "SalePrice"},
+		{"margin equal to decimal cost", func(p *Pricing) { p.Margin = "10" }, true, "Margin"},
+		{"quantity below nested minimum", func(p *Pricing) { p.Quantity = 0 }, true, "Quantity"},
+		{"deposit equal to regular", func(p *Pricing) { p.Deposit = "100" }, true, "Deposit"},
+		{"total differs", func(p *Pricing) { p.Total = "100.01" }, true, "Total"},
+		{"invalid referenced value", func(p *Pricing) { p.RegularPrice = "abc" }, true, "SalePrice"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			input := valid
+			tt.modify(&input)
+
+			err := v.Struct(input)
+			if !tt.wantErr {
+				assert.NoError(t, err)
+				return
+			}
+
+			require.Error(t, err)
+			validationErrors, ok := err.(validator.ValidationErrors)
+			require.True(t, ok)
+			assert.Equal(t, tt.field, validationErrors[0].Field())
+		})
+	}
+}
+
+func TestValidateDecimalFieldOperation_MissingField(t *testing.T) {
+	v, err := NewValidator()
+	require.NoError(t, err)
+
+	type Invalid struct {
+		Max string
+		Min string `validate:"dltefield=Maxx"`
+	}
+
+	var configErr *ConfigError
+	require.ErrorAs(t, v.Struct(Invalid{Max: "10", Min: "1"}), &configErr)
+	assert.Equal(t, "dltefield", configErr.Tag)
+	assert.Equal(t, "Maxx", configErr.Param)
+}
+
+func TestParseDecimalSumParam(t *testing.T) {
+	tests := []struct {
+		name     string
+		param    string
+		expected []decimalSumTerm
+		ok       bool
+	}{
+		{
+			name:  "addition only",
+			param: "Subtotal+Tax",
+			expected: []decimalSumTerm{
+				{field: "Subtotal"},
+				{field: "Tax"},
+			},
+			ok: true,
+		},
+		{
+			name:  "addition and subtraction",
+			param: "Subtotal+Tax+ShippingFee-Discount",
+			expected: []decimalSumTerm{
+				{field: "Subtotal"},
+				{field: "Tax"},
+				{field: "ShippingFee"},
+				{field: "Discount", negative: true},
+			},
+			ok: true,
+		},
+		{
+			name:  "leading minus and constant",
+			param: "-Refund+5.50",
+			expected: []decimalSumTerm{
+				{field: "Refund", negative: true},
+				{constant: decimal.RequireFromString("5.50")},
+			},
+			ok: true,
+		},
+		{
+			name:  "nested field path",
+			param: "Summary.Subtotal",
+			expected: []decimalSumTerm{
+				{field: "Summary.Subtotal"},
+			},
+			ok: true,
+		},
+		{
+			name:  "exponent constants",
+			param: "Subtotal+1e+5-2.5E-3",
+			expected: []decimalSumTerm{
+				{field: "Subtotal"},
+				{constant: decimal.RequireFromString("100000")},
+				{constant: decimal.RequireFromString("0.0025"), negative: true},
+			},
+			ok: true,
+		},
+		{
+			name:  "field name ending in e is not an exponent",
+			param: "Fee-Discount",
+			expected: []decimalSumTerm{
+				{field: "Fee"},
+				{field: "Discount", negative: true},
+			},
+			ok: true,
+		},
+		{"empty expression", "", nil, false},
+		{"dangling operator", "Subtotal+", nil, false},
+		{"double operator", "Subtotal+-Tax", nil, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			terms, ok := parseDecimalSumParam(tt.param)
+			assert.Equal(t, tt.ok, ok)
+			if !tt.ok {
+				return
+			}
+
+			require.Len(t, terms, len(tt.expected))
+			for i, term := range terms {
+				assert.Equal(t, tt.expected[i].field, term.field)
+				assert.Equal(t, tt.expected[i].negative, term.negative)
+				assert.True(t, tt.expected[i].constant.Equal(term.constant))
+			}
+		})
+	}
+}
+
+func TestValidateDecimalSum(t *testing.T) {
+	v := validator.New()
+	RegisterDecimalValidators(v)
+
+	type Order struct {
+		Subtotal    string
+		Tax         string
+		ShippingFee decimal.Decimal
+		Discount    string
+		Total       string `validate:"dsum=Subtotal+Tax+ShippingFee-Discount"`
+	}
+
+	tests := []struct {
+		name    string
+		input   Order
+		wantErr bool
+	}{
+		{
+			name:    "total reconciles",
+			input:   Order{Subtotal: "100.00", Tax: "7.00", ShippingFee: decimal.RequireFromString("50"), Discount: "10.50", Total: "146.50"},
+			wantErr: false,
+		},
+		{
+			name:    "total off by one cent",
+			input:   Order{Subtotal: "100.00", Tax: "7.00", ShippingFee: decimal.RequireFromString("50"), Discount: "10.50", Total: "146.51"},
+			wantErr: true,
+		},
+		{
+			name:    "invalid operand",
+			input:   Order{Subtotal: "100.00", Tax: "n/a", ShippingFee: decimal.RequireFromString("50"), Discount: "10.50", Total: "146.50"},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := v.Struct(tt.input)
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestValidateDecimalSum_ConfigError(t *testing.T) {
+	v, err := NewValidator()
+	require.NoError(t, err)
+
+	type MissingField struct {
+		Subtotal string
+		Total    string `validate:"dsum=Subtotal+Missing"`
+	}
+	type DanglingOperator struct {
+		Subtotal string
+		Total    string `validate:"dsum=Subtotal+"`
+	}
+
+	for _, input := range []any{MissingField{Subtotal: "10", Total: "10"}, DanglingOperator{Subtotal: "10", Total: "10"}} {
+		var configErr *ConfigError
+		require.ErrorAs(t, v.Struct(input), &configErr)
+		assert.Equal(t, "dsum", configErr.Tag)
+	}
+}
+
+func TestValidateDecimalCurrency(t *testing.T) {
+	v := validator.New()
+	RegisterDecimalValidators(v)
+
+	type Payment struct {
+		Currency string
+		Amount   string `validate:"decimal_currency=Currency"`
+	}
+
+	tests := []struct {
+		name    string
+		input   Payment
+		wantErr bool
+	}{
+		{"THB with two decimals", Payment{Currency: "THB", Amount: "100.25"}, false},
+		{"THB with three decimals", Payment{Currency: "THB", Amount: "100.255"}, true},
+		{"JPY integer", Payment{Currency: "JPY", Amount: "1500"}, false},
+		{"JPY with decimals", Payment{Currency: "JPY", Amount: "1500.5"}, true},
+		{"BHD with three decimals", Payment{Currency: "BHD", Amount: "12.345"}, false},
+		{"BHD with four decimals", Payment{Currency: "BHD", Amount: "12.3456"}, true},
+		{"unknown currency", Payment{Currency: "XYZ", Amount: "1"}, true},
+		{"invalid amount", Payment{Currency: "USD", Amount: "abc"}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := v.Struct(tt.input)
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestValidateDecimalCurrency_ConfigError(t *testing.T) {
+	v, err := NewValidator()
+	require.NoError(t, err)
+
+	type MissingField struct {
+		Currency string
+		Amount   string `validate:"decimal_currency=Curency"`
+	}
+	type NonStringField struct {
+		Currency int
+		Amount   string `validate:"decimal_currency=Currency"`
+	}
+
+	for _, input := range []any{MissingField{Currency: "THB", Amount: "1"}, NonStringField{Currency: 764, Amount: "1"}} {
+		var configErr *ConfigError
+		require.ErrorAs(t, v.Struct(input), &configErr)
+		assert.Equal(t, "decimal_currency", configErr.Tag)
+	}
+
+	type NilCurrency struct {
+		Currency *string
+		Amount   string `validate:"decimal_currency=Currency"`
+	}
+	var validationErrors validator.ValidationErrors
+	assert.ErrorAs(t, v.Struct(NilCurrency{Amount: "1"}), &validationErrors)
+}
+
+func TestCurrencyMinorUnits(t *testing.T) {
+	tests := []struct {
+		code     string
+		expected int32
+		ok       bool
+	}{
+		{"THB", 2, true},
+		{"JPY", 0, true},
+		{"BHD", 3, true},
+		{"CLF", 4, true},
+		{"XAU", 0, false},
+		{"thb", 0, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.code, func(t *testing.T) {
+			units, ok := CurrencyMinorUnits(tt.code)
+			assert.Equal(t, tt.ok, ok)
+			assert.Equal(t, tt.expected, units)
+		})
+	}
+}
+
+func TestValidateDecimalMultipleOf(t *testing.T) {
+	v := validator.New()
+	RegisterDecimalValidators(v)
+
+	tests := []struct {
+		name    string
+		value   any
+		tag     string
+		wantErr bool
+	}{
+		{"exact multiple", "1.75", "dmultipleof=0.25", false},
+		{"zero is a multiple", "0", "dmultipleof=0.25", false},
+		{"negative multiple", "-0.50", "dmultipleof=0.25", false},
+		{"not a multiple", "1.80", "dmultipleof=0.25", true},
+		{"cash rounding valid", "10.05", "dmultipleof=0.05", false},
+		{"cash rounding invalid", "10.07", "dmultipleof=0.05", true},
+		{"tick size without float drift", "0.3", "dmultipleof=0.1", false},
+		{"integer field", 150, "dmultipleof=50", false},
+		{"invalid value", "abc", "dmultipleof=1", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := v.Var(tt.value, tt.tag)
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+
+	t.Run("invalid step is a config error", func(t *testing.T) {
+		xv, err := NewValidator()
+		require.NoError(t, err)
+
+		for _, tag := range []string{"dmultipleof=0", "dmultipleof=-1", "dmultipleof=abc"} {
+			var configErr *ConfigError
+			require.ErrorAs(t, xv.Var("1", tag), &configErr, tag)
+			assert.Equal(t, "dmultipleof", configErr.Tag)
+		}
+	})
+}
+
+func TestValidateDecimalSign(t *testing.T) {
+	v := validator.New()
+	RegisterDecimalValidators(v)
+
+	tests := []struct {
+		name    string
+		value   any
+		tag     string
+		wantErr bool
+	}{
+		{"dpos - positive", "0.01", "dpos", false},
+		{"dpos - zero", "0.00", "dpos", true},
+		{"dpos - negative", "-1", "dpos", true},
+		{"dneg - negative", "-0.01", "dneg", false},
+		{"dneg - zero", "0", "dneg", true},
+		{"dneg - positive", "1", "dneg", true},
+		{"dnonzero - positive", "5", "dnonzero", false},
+		{"dnonzero - negative", "-5", "dnonzero", false},
+		{"dnonzero - zero with scale", "0.000", "dnonzero", true},
+		{"dpos - integer field", 3, "dpos", false},
+		{"dpos - decimal field", decimal.NewFromInt(-3), "dpos", true},
+		{"dpos - invalid value", "abc", "dpos", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := v.Var(tt.value, tt.tag)
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestDecimalSignificantDigits(t *testing.T) {
+	tests := []struct {
+		value    string
+		expected int
+	}{
+		{"0", 0},
+		{"0.00", 0},
+		{"35.1234", 6},
+		{"0.001230", 3},
+		{"1230", 3},
+		{"-12.5", 3},
+		{"100.001", 6},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			value := decimal.RequireFromString(tt.value)
+			assert.Equal(t, tt.expected, decimalSignificantDigits(value))
+		})
+	}
+}
+
+func TestValidateDecimalSignificantDigits(t *testing.T) {
+	v := validator.New()
+	RegisterDecimalValidators(v)
+
+	tests := []struct {
+		name    string
+		value   any
+		tag     string
+		wantErr bool
+	}{
+		{"within limit", "35.1234", "dsigits=6", false},
+		{"small rate within limit", "0.000012345", "dsigits=6", false},
+		{"trailing zeros ignored", "1.500000", "dsigits=2", false},
+		{"exceeds limit", "35.12345", "dsigits=6", true},
+		{"large integer exceeds limit", "1234567", "dsigits=6", true},
+		{"float field", 1.5, "dsigits=2", false},
+		{"invalid value", "abc", "dsigits=6", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := v.Var(tt.value, tt.tag)
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+
+	t.Run("invalid limit is a config error", func(t *testing.T) {
+		xv, err := NewValidator()
+		require.NoError(t, err)
+
+		for _, tag := range []string{"dsigits=abc", "dsigits=0", "dsigits=-2"} {
+			var configErr *ConfigError
+			require.ErrorAs(t, xv.Var("1", tag), &configErr, tag)
+			assert.Equal(t, "dsigits", configErr.Tag)
+		}
+	})
+}
+
+func TestIsCanonicalDecimal(t *testing.T) {
+	tests := []struct {
+		name               string
+		value              string
+		allowTrailingZeros bool
+		expected           bool
+	}{
+		{"integer", "100", true, true},
+		{"zero", "0", true, true},
+		{"fraction", "100.50", true, true},
+		{"negative", "-0.5", true, true},
+		{"leading zero fraction", "0.05", true, true},
+		{"exponent notation", "1e3", true, false},
+		{"leading zeros", "007", true, false},
+		{"leading plus", "+1", true, false},
+		{"missing integer part", ".5", true, false},
+		{"trailing dot", "5.", true, false},
+		{"negative zero", "-0.00", true, false},
+		{"surrounding whitespace", " 1.5", true, false},
+		{"empty", "", true, false},
+		{"trailing zeros rejected", "100.50", false, false},
+		{"no trailing zeros", "100.5", false, true},
+		{"integer with zeros kept", "100", false, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, isCanonicalDecimal(tt.value, tt.allowTrailingZeros))
+		})
+	}
+}
+
+func TestValidateDecimalCanonical(t *testing.T) {
+	v := validator.New()
+	RegisterDecimalValidators(v)
+
+	tests := []struct {
+		name    string
+		value   string
+		tag     string
+		wantErr bool
+	}{
+		{"canonical value", "1234.50", "decimal_canonical", false},
+		{"exponent rejected", "1.2345E3", "decimal_canonical", true},
+		{"trailing zeros allowed by default", "10.10", "decimal_canonical", false},
+		{"trailing zeros rejected with notrailing", "10.10", "decimal_canonical=notrailing", true},
+		{"normalized with notrailing", "10.1", "decimal_canonical=notrailing", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := v.Var(tt.value, tt.tag)
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+
+	t.Run("unknown option is a config error", func(t *testing.T) {
+		xv, err := NewValidator()
+		require.NoError(t, err)
+
+		var configErr *ConfigError
+		require.ErrorAs(t, xv.Var("10.1", "decimal_canonical=bogus"), &configErr)
+		assert.Equal(t, "decimal_canonical", configErr.Tag)
+	})
+}
+
+func TestNormalizeLooseDecimal(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected string
+		ok       bool
+	}{
+		{"grouped with whitespace", "1,234.50 ", "1234.50", true},
+		{"multiple groups", "1,234,567.89", "1234567.89", true},
+		{"negative grouped", "-12,345", "-12345", true},
+		{"plain decimal", "  42.1\t", "42.1", true},
+		{"no integer grouping", "1234.5", "1234.5", true},
+		{"bad group size", "12,34.5", "", false},
+		{"leading group too long", "1234,567", "", false},
+		{"separator in fraction", "1.234,5", "", false},
+		{"empty leading group", ",123", "", false},
+		{"not a number", "abc", "", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			normalized, ok := NormalizeLooseDecimal(tt.input)
+			assert.Equal(t, tt.ok, ok)
+			assert.Equal(t, tt.expected, normalized)
+		})
+	}
+}
+
+func TestValidateDecimalLoose(t *testing.T) {
+	v := validator.New()
+	RegisterDecimalValidators(v)
+
+	tests := []struct {
+		name    string
+		value   string
+		tag     string
+		wantErr bool
+	}{
+		{"formatted value", "1,234.50 ", "decimal_loose=10:2", false},
+		{"formatted value exceeds scale", "1,234.567", "decimal_loose=10:2", true},
+		{"formatted value exceeds precision", "123,456,789.00", "decimal_loose=10:2", true},
+		{"default precision and scale", " 9,999.123456 ", "decimal_loose", false},
+		{"malformed grouping", "12,34.50", "decimal_loose=10:2", true},
+		{"strict decimal still rejects", "1,234.50", "decimal=10:2", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := v.Var(tt.value, tt.tag)
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestValidateDecimalIf_NestedAndPointerParents(t *testing.T) {
+	v := validator.New()
+	RegisterDecimalValidators(v)
+
+	type Payment struct {
+		Method string
+	}
+
+	type Line struct {
+		Mode   string
+		Amount string `validate:"decimal_if=0@Mode=integer"`
+	}
+
+	type Order struct {
+		Payment  *Payment
+		Settings Payment
+		Amount   string `validate:"decimal_if=2@Payment.Method=card"`
+		Fee      string `validate:"decimal_if=0@Settings.Method=cash"`
+		Line     *Line
+	}
+
+	tests := []struct {
+		name    string
+		input   any
+		wantErr bool
+	}{
+		{
+			name:    "nested pointer condition met and valid",
+			input:   Order{Payment: &Payment{Method: "card"}, Amount: "10.25", Fee: "1.5"},
+			wantErr: false,
+		},
+		{
+			name:    "nested pointer condition met and invalid",
+			input:   Order{Payment: &Payment{Method: "card"}, Amount: "10.255", Fee: "1.5"},
+			wantErr: true,
+		},
+		{
+			name:    "nil pointer along path skips validation",
+			input:   Order{Amount: "10.255", Fee: "1.5"},
+			wantErr: false,
+		},
+		{
+			name:    "nested value struct condition met",
+			input:   Order{Settings: Payment{Method: "cash"}, Amount: "1", Fee: "1.5"},
+			wantErr: true,
+		},
+		{
+			name:    "pointer parent struct",
+			input:   Order{Line: &Line{Mode: "integer", Amount: "1.5"}},
+			wantErr: true,
+		},
+		{
+			name:    "struct passed by pointer",
+			input:   &Order{Payment: &Payment{Method: "card"}, Amount: "10.255"},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := v.Struct(tt.input)
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestValidateDecimalIf_NonStringConditionFields(t *testing.T) {
+	v := validator.New()
+	RegisterDecimalValidators(v)
+
+	type PaymentType string
+
+	type Request struct {
+		Mode      int
+		IsCash    bool
+		Type      PaymentType
+		Rate      float64
+		Priority  *uint8
+		Amount    string `validate:"decimal_if=0@Mode=1"`
+		CashFee   string `validate:"decimal_if=0@IsCash=true"`
+		TypeFee   string `validate:"decimal_if=2@Type=credit"`
+		RateFee   string `validate:"decimal_if=1@Rate=1.5"`
+		UrgentFee string `validate:"decimal_if=0@Priority=9"`
+	}
+
+	priority := uint8(9)
+
+	tests := []struct {
+		name    string
+		input   Request
+		wantErr bool
+	}{
+		{"int condition met and invalid", Request{Mode: 1, Amount: "1.5"}, true},
+		{"int condition not met", Request{Mode: 2, Amount: "1.5"}, false},
+		{"bool condition met and invalid", Request{IsCash: true, CashFee: "1.5"}, true},
+		{"bool condition not met", Request{IsCash: false, CashFee: "1.5"}, false},
+		{"custom string type condition met", Request{Type: "credit", TypeFee: "1.555"}, true},
+		{"float condition met", Request{Rate: 1.5, RateFee: "1.55"}, true},
+		{"pointer uint condition met", Request{Priority: &priority, UrgentFee: "1.5"}, true},
+		{"nil pointer condition not met", Request{UrgentFee: "1.5"}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := v.Struct(tt.input)
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestValidateDecimalIf_MultipleConditions(t *testing.T) {
+	v := validator.New()
+	RegisterDecimalValidators(v)
+
+	type Payment struct {
+		Type    string
+		Region  string
+		Amount  string `validate:"decimal_if=2@Type=credit&Region=TH"`
+		Fee     string `validate:"decimal_if=0@Type=credit0x7CType=debit"`
+		Deposit string `validate:"decimal_if=0@Type=cash&Region=TH0x7CType=cheque"`
+	}
+
+	tests := []struct {
+		name    string
+		input   Payment
+		wantErr bool
+	}{
+		{"AND met and valid", Payment{Type: "credit", Region: "TH", Amount: "1.25", Fee: "1"}, false},
+		{"AND met and invalid", Payment{Type: "credit", Region: "TH", Amount: "1.255", Fee: "1"}, true},
+		{"AND partially met", Payment{Type: "credit", Region: "US", Amount: "1.255", Fee: "1"}, false},
+		{"OR first alternative met", Payment{Type: "credit", Fee: "1.5"}, true},
+		{"OR second alternative met", Payment{Type: "debit", Fee: "1.5"}, true},
+		{"OR not met", Payment{Type: "wallet", Fee: "1.5"}, false},
+		{"mixed AND group met", Payment{Type: "cash", Region: "TH", Deposit: "1.5"}, true},
+		{"mixed OR alternative met", Payment{Type: "cheque", Deposit: "1.5"}, true},
+		{"mixed not met", Payment{Type: "cash", Region: "US", Deposit: "1.5"}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := v.Struct(tt.input)
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestValidateDecimalIf_ConfigurationErrors(t *testing.T) {
+	v := validator.New()
+	RegisterDecimalValidators(v)
+
+	type MissingField struct {
+		Amount string `validate:"decimal_if=2@Missing=x"`
+	}
+
+	type MalformedParam struct {
+		Mode   string
+		Amount string `validate:"decimal_if=2Mode=x"`
+	}
+
+	tests := []struct {
+		name   string
+		input  any
+		reason string
+	}{
+		{"nonexistent field", MissingField{Amount: "1"}, "condition references a field that does not exist"},
+		{"malformed parameter", MalformedParam{Amount: "1"}, "expected format rule@Field=value"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			defer func() {
+				r := recover()
+				require.NotNil(t, r)
+				configErr, ok := r.(*ConfigError)
+				require.True(t, ok)
+				assert.Equal(t, "decimal_if", configErr.Tag)
+				assert.Equal(t, tt.reason, configErr.Reason)
+			}()
+			_ = v.Struct(tt.input)
+		})
+	}
+}
+
+func TestScanDecimalDigits(t *testing.T) {
+	tests := []struct {
+		value         string
+		integerDigits int32
+		decimalPlaces int32
+		ok            bool
+	}{
+		{"123.45", 3, 2, true},
+		{"-123.45", 3, 2, true},
+		{"+7", 1, 0, true},
+		{"0", 1, 0, true},
+		{"0.000", 1, 0, true},
+		{"00012.3400", 2, 2, true},
+		{"0.05", 1, 2, true},
+		{"1e3", 0, 0, false},
+		{".5", 0, 0, false},
+		{"5.", 0, 0, false},
+		{"-", 0, 0, false},
+		{"", 0, 0, false},
+		{"1.2.3", 0, 0, false},
+		{" 1", 0, 0, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			integerDigits, decimalPlaces, ok := scanDecimalDigits(tt.value)
+			assert.Equal(t, tt.ok, ok)
+			assert.Equal(t, tt.integerDigits, integerDigits)
+			assert.Equal(t, tt.decimalPlaces, decimalPlaces)
+		})
+	}
+}
+
+func TestValidateDecimalString_MatchesDecimalParsing(t *testing.T) {
+	values := []string{
+		"0", "-0", "0.00", "123.45", "-123.456", "12345678", "123456789", "00012.3400",
+		"1.500", "+1.5", "0.000000000000000001", "12345678901234567890.123456789012345678",
+		".5", "5.", "1e3", "1.5E-2", "abc", "", "-", "1,000",
+	}
+	rules := []struct{ precision, scale int32 }{
+		{10, 2}, {10, 0}, {5, 2}, {DefaultPrecision, DefaultScale}, {3, 3},
+	}
+
+	for _, value := range values {
+		for _, rule := range rules {
+			expected := false
+			if parsed, err := decimal.NewFromString(value); err == nil {
+				expected = validateDecimalPrecisionScale(parsed, rule.precision, rule.scale)
+			}
+
+			assert.Equal(t, expected, validateDecimalString(value, rule.precision, rule.scale),
+				"value %q with %d:%d", value, rule.precision, rule.scale)
+		}
+	}
+}
+
+func BenchmarkValidateDecimalString(b *testing.B) {
+	values := []string{"123.45", "-98765.4321", "0.000001", "12345678901234567890.12"}
+
+	for _, value := range values {
+		b.Run("scan/"+value, func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				validateDecimalString(value, 38, 18)
+			}
+		})
+		b.Run("parse/"+value, func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				parsed, _ := decimal.NewFromString(value)
+				validateDecimalPrecisionScale(parsed, 38, 18)
+			}
+		})
+	}
+}
+
+func BenchmarkValidateDecimal_Struct(b *testing.B) {
+	v := validator.New()
+	RegisterDecimalValidators(v)
+
+	type Invoice struct {
+		Subtotal string `validate:"decimal=10:2"`
+		Tax      string `validate:"decimal=10:2"`
+		Shipping string `validate:"decimal=10:2"`
+		Discount string `validate:"decimal=10:2"`
+		Total    string `validate:"decimal=10:2"`
+		Rate     string `validate:"decimal=18:8"`
+		Quantity string `validate:"decimal=0"`
+		Weight   string `validate:"decimal=3"`
+	}
+
+	invoice := Invoice{
+		Subtotal: "47680.00", Tax: "3337.60", Shipping: "200.00", Discount: "500.00",
+		Total: "50717.60", Rate: "35.12345678", Quantity: "12", Weight: "1.250",
+	}
+
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_ = v.Struct(invoice)
+	}
+}
+
+func TestCachedDecimalParams(t *testing.T) {
+	params := []string{"", "2", "10:6", "invalid", "abc:6"}
+
+	for _, param := range params {
+		t.Run(param, func(t *testing.T) {
+			expectedPrecision, expectedScale := parseDecimalParams(param)
+
+			// First call parses and stores, second call is served from the cache
+			for i := 0; i < 2; i++ {
+				precision, scale := cachedDecimalParams(param)
+				assert.Equal(t, expectedPrecision, precision)
+				assert.Equal(t, expectedScale, scale)
+			}
+		})
+	}
+}
+
+func TestCachedDecimalIfParams(t *testing.T) {
+	t.Run("valid parameter", func(t *testing.T) {
+		for i := 0; i < 2; i++ {
+			params := cachedDecimalIfParams("10:2@Type=credit&Region=TH")
+			require.NoError(t, params.err)
+			assert.Equal(t, int32(10), params.precision)
+			assert.Equal(t, int32(2), params.scale)
+			assert.Equal(t, "Type equals 'credit' and Region equals 'TH'", params.conditions.String())
+		}
+	})
+
+	t.Run("malformed parameter", func(t *testing.T) {
+		for i := 0; i < 2; i++ {
+			params := cachedDecimalIfParams("2Mode=x")
+			assert.Error(t, params.err)
+		}
+	})
+}
+
+func BenchmarkDecimalParams(b *testing.B) {
+	b.Run("parse", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			parseDecimalParams("38:18")
+		}
+	})
+	b.Run("cached", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			cachedDecimalParams("38:18")
+		}
+	})
+}
+
+func BenchmarkDecimalIfParams(b *testing.B) {
+	b.Run("parse", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			rule, _, _ := parseDecimalIfParam("10:2@Type=credit&Region=TH")
+			parseDecimalParams(rule)
+		}
+	})
+	b.Run("cached", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			cachedDecimalIfParams("10:2@Type=credit&Region=TH")
+		}
+	})
+}
@@ -0,0 +1,213 @@
+package xvalidator
+
+import (
+	"reflect"
+	"regexp"
+	"strings"
+
+	"github.com/go-playground/validator/v10"
+)
+
+// Machine readable zone validation logic functions
+
+// mrzTD3LineLength is the length of each of the two lines of a TD3 (passport) machine readable zone.
+const mrzTD3LineLength = 44
+
+// mrzCharValue returns the check digit value of an MRZ character: digits are worth their value, A to Z
+// 10 to 35 and the "<" filler 0 (ICAO 9303 part 3).
+func mrzCharValue(c byte) (int, bool) {
+	switch {
+	case c >= '0' && c <= '9':
+		return int(c - '0'), true
+	case c >= 'A' && c <= 'Z':
+		return int(c-'A') + 10, true
+	case c == '<':
+		return 0, true
+	default:
+		return 0, false
+	}
+}
+
+// mrzCheckDigit returns the ICAO 9303 check digit of an MRZ field: the sum of its character values weighted
+// 7, 3, 1 repeatedly, modulo 10.
+func mrzCheckDigit(field string) (byte, bool) {
+	weights := [3]int{7, 3, 1}
+	sum := 0
+	for i := 0; i < len(field); i++ {
+		value, ok := mrzCharValue(field[i])
+		if !ok {
+			return 0, false
+		}
+		sum += value * weights[i%3]
+	}
+	return byte('0' + sum%10), true
+}
+
+// mrzCheckValid reports whether check is the check digit of field.
+func mrzCheckValid(field string, check byte) bool {
+	expected, ok := mrzCheckDigit(field)
+	return ok && check == expected
+}
+
+// isMRZAlpha reports whether s holds only letters and "<" fillers, as in state codes and names.
+func isMRZAlpha(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if (s[i] < 'A' || s[i] > 'Z') && s[i] != '<' {
+			return false
+		}
+	}
+	return true
+}
+
+// isMRZDate reports whether s is a YYMMDD date with a valid month and a day that exists in that month
+// in some year (the century is not known, so February 29 is allowed).
+func isMRZDate(s string) bool {
+	if len(s) != 6 || !isASCIIDigits(s) {
+		return false
+	}
+	month := int(s[2]-'0')*10 + int(s[3]-'0')
+	day := int(s[4]-'0')*10 + int(s[5]-'0')
+	daysInMonth := [13]int{0, 31, 29, 31, 30, 31, 30, 31, 31, 30, 31, 30, 31}
+	return month >= 1 && month <= 12 && day >= 1 && day <= daysInMonth[month]
+}
+
+// splitMRZTD3 splits a TD3 machine readable zone into its two lines. The lines may be separated by a
+// newline ("\n" or "\r\n") or given as one 88-character string; surrounding whitespace is ignored.
+func splitMRZTD3(mrz string) (line1, line2 string, ok bool) {
+	mrz = strings.TrimSpace(mrz)
+	if line1, line2, found := strings.Cut(mrz, "\n"); found {
+		line1 = strings.TrimSuffix(line1, "\r")
+		return line1, line2, len(line1) == mrzTD3LineLength && len(line2) == mrzTD3LineLength
+	}
+	if len(mrz) != 2*mrzTD3LineLength {
+		return "", "", false
+	}
+	return mrz[:mrzTD3LineLength], mrz[mrzTD3LineLength:], true
+}
+
+// isMRZTD3 reports whether line1 and line2 form a valid TD3 machine readable zone: a "P" document code,
+// letter state codes, YYMMDD dates, a known sex marker, and valid check digits for the document number,
+// date of birth, expiry date, personal number and the composite of line 2.
+func isMRZTD3(line1, line2 string) bool {
+	for _, line := range []string{line1, line2} {
+		for i := 0; i < len(line); i++ {
+			if _, ok := mrzCharValue(line[i]); !ok {
+				return false
+			}
+		}
+	}
+
+	// Line 1: document code, issuing state and names
+	if line1[0] != 'P' || !isMRZAlpha(line1[1:2]) || !isMRZAlpha(line1[2:5]) || line1[2] == '<' ||
+		!isMRZAlpha(line1[5:]) || line1[5] == '<' {
+		return false
+	}
+
+	// Line 2: document number, nationality, date of birth, sex, expiry date and personal number
+	documentNumber, nationality, birthDate, sex, expiryDate := line2[0:9], line2[10:13], line2[13:19], line2[20], line2[21:27]
+	personalNumber, personalCheck := line2[28:42], line2[42]
+	if !isMRZAlpha(nationality) || nationality[0] == '<' || !isMRZDate(birthDate) || !isMRZDate(expiryDate) ||
+		!strings.ContainsRune("MFX<", rune(sex)) {
+		return false
+	}
+	if !mrzCheckValid(documentNumber, line2[9]) || !mrzCheckValid(birthDate, line2[19]) ||
+		!mrzCheckValid(expiryDate, line2[27]) {
+		return false
+	}
+	// An unused personal number may have a "<" filler instead of the check digit 0
+	if !mrzCheckValid(personalNumber, personalCheck) &&
+		!(personalCheck == '<' && personalNumber == strings.Repeat("<", len(personalNumber))) {
+		return false
+	}
+	return mrzCheckValid(line2[0:10]+line2[13:20]+line2[21:43], line2[43])
+}
+
+// validateMRZ validates the machine readable zone of a passport in the ICAO 9303 TD3 format: two lines of
+// 44 characters (A-Z, 0-9 and "<"), separated by a newline or concatenated, with valid check digits for the
+// document number, date of birth, expiry date, personal number and the composite check digit.
+// Expiry is not compared with the current date.
+// Usage:
+//   - `validate:"mrz"` - e.g., "P<UTOERIKSSON<<ANNA<MARIA<<<<<<<<<<<<<<<<<<<\nL898902C36UTO7408122F1204159ZE184226B<<<<<10"
+func validateMRZ(fl validator.FieldLevel) bool {
+	field := fl.Field()
+	if field.Kind() != reflect.String {
+		return false
+	}
+
+	line1, line2, ok := splitMRZTD3(field.String())
+	return ok && isMRZTD3(line1, line2)
+}
+
+// Passport number validation logic functions
+
+// isPassportCountryParam reports whether a passport rule parameter token is an ISO 3166-1 alpha-2 country code
+// rather than the name of a field holding one.
+func isPassportCountryParam(token string) bool {
+	_, ok := CountryAlpha3(token)
+	return ok
+}
+
+// passportFieldCountry returns the ISO 3166-1 alpha-2 code for a country field value written as an alpha-2 or
+// alpha-3 code in either case, such as "TH", "th" or "THA".
+func passportFieldCountry(value string) (string, bool) {
+	country := strings.ToUpper(value)
+	if alpha2, ok := CountryAlpha2(country); ok {
+		return alpha2, true
+	}
+	return country, isPassportCountryParam(country)
+}
+
+// validatePassport returns a validator for passport numbers in the format of their issuing country, as
+// registered in passports. The parameter lists the accepted issuing countries as ISO 3166-1 alpha-2 codes;
+// the number must match the format of one of them. A token that is not a country code names a sibling
+// field holding the issuing country as an alpha-2 or alpha-3 code in either case, such as the nationality;
+// countries without a known format then fall back to the generic ICAO 9303 form of 6 to 9 uppercase letters
+// and digits, which is also used without a parameter. Tokens are checked as country codes first, so fields
+// named like one (ID, IN, IT) cannot be referenced. Country codes without a format are a ConfigError; add
+// formats with Validator.RegisterPassportFormat.
+// Usage:
+//   - `validate:"passport"` - e.g., "AA1234567"
+//   - `validate:"passport=TH US GB"` - a Thai, US or British passport number
+//   - `validate:"passport=Nationality"` - the format of the country in the Nationality field
+func validatePassport(passports *passportRegistry) validator.Func {
+	return func(fl validator.FieldLevel) bool {
+		field := fl.Field()
+		if field.Kind() != reflect.String {
+			return false
+		}
+		number := field.String()
+
+		tokens := strings.Fields(fl.Param())
+		if len(tokens) == 0 {
+			return genericPassportRegex().MatchString(number)
+		}
+
+		matched := false
+		for _, token := range tokens {
+			var format *regexp.Regexp
+			if isPassportCountryParam(token) {
+				var ok bool
+				if format, ok = passports.lookup(token); !ok {
+					panicConfigError(fl, "no passport format is registered for "+token)
+				}
+			} else {
+				countryField, found := lookupFieldPath(fl.Parent(), token)
+				if !found {
+					panicConfigError(fl, "passport references a field that does not exist")
+				}
+				if !countryField.IsValid() || countryField.Kind() != reflect.String {
+					continue
+				}
+				country, ok := passportFieldCountry(countryField.String())
+				if !ok {
+					continue
+				}
+				if format, ok = passports.lookup(country); !ok {
+					format = genericPassportRegex()
+				}
+			}
+			matched = matched || format.MatchString(number)
+		}
+		return matched
+	}
+}
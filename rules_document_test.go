@@ -0,0 +1,171 @@
+package xvalidator
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateMRZ(t *testing.T) {
+	v, err := NewValidator()
+	require.NoError(t, err)
+
+	// ICAO 9303 part 4 specimen
+	const (
+		line1 = "P<UTOERIKSSON<<ANNA<MARIA<<<<<<<<<<<<<<<<<<<"
+		line2 = "L898902C36UTO7408122F1204159ZE184226B<<<<<10"
+	)
+	// withChar returns line2 with the character at i replaced by c.
+	withChar := func(i int, c string) string {
+		return line2[:i] + c + line2[i+1:]
+	}
+
+	tests := []struct {
+		name    string
+		value   any
+		wantErr bool
+	}{
+		{"newline separated", line1 + "\n" + line2, false},
+		{"crlf separated", line1 + "\r\n" + line2, false},
+		{"concatenated", line1 + line2, false},
+		{"surrounding whitespace", "\n" + line1 + "\n" + line2 + "\n", false},
+		{"no personal number", line1 + "\n" + "L898902C36UTO7408122F1204159<<<<<<<<<<<<<<08", false},
+		{"no personal number with filler check", line1 + "\n" + "L898902C36UTO7408122F1204159<<<<<<<<<<<<<<<8", false},
+		{"bad document number check digit", line1 + "\n" + withChar(9, "7"), true},
+		{"bad birth date check digit", line1 + "\n" + withChar(19, "3"), true},
+		{"bad expiry check digit", line1 + "\n" + withChar(27, "8"), true},
+		{"bad personal number check digit", line1 + "\n" + withChar(42, "2"), true},
+		{"bad composite check digit", line1 + "\n" + withChar(43, "1"), true},
+		{"altered document number", line1 + "\n" + withChar(0, "M"), true},
+		{"invalid birth month", line1 + "\n" + "L898902C36UTO7413122F1204159ZE184226B<<<<<10", true},
+		{"invalid sex", line1 + "\n" + withChar(20, "Z"), true},
+		{"lowercase", strings.ToLower(line1) + "\n" + line2, true},
+		{"not a passport", "I" + line1[1:] + "\n" + line2, true},
+		{"missing names", "P<UTO" + strings.Repeat("<", 39) + "\n" + line2, true},
+		{"short line", line1[:43] + "\n" + line2, true},
+		{"single line", line2, true},
+		{"empty", "", true},
+		{"non-string", 42, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := v.Var(tt.value, "mrz")
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestMRZCheckDigit(t *testing.T) {
+	for field, expected := range map[string]byte{"L898902C3": '6', "740812": '2', "120415": '9', "ZE184226B<<<<<": '1', "<<<<<<<<<<<<<<": '0'} {
+		check, ok := mrzCheckDigit(field)
+		require.True(t, ok, field)
+		assert.Equal(t, string(expected), string(check), field)
+	}
+
+	_, ok := mrzCheckDigit("l898902c3")
+	assert.False(t, ok)
+}
+
+func TestValidatePassport(t *testing.T) {
+	v, err := NewValidator()
+	require.NoError(t, err)
+
+	tests := []struct {
+		name    string
+		value   any
+		tag     string
+		wantErr bool
+	}{
+		{"generic", "AA1234567", "passport", false},
+		{"generic short", "123456", "passport", false},
+		{"thai", "AA1234567", "passport=TH", false},
+		{"thai single letter", "K123456", "passport=TH", false},
+		{"us digits", "123456789", "passport=US", false},
+		{"us next generation", "A12345678", "passport=US", false},
+		{"british", "123456789", "passport=GB", false},
+		{"any listed country", "AA1234567", "passport=US GB TH", false},
+		{"german", "C01X00T47", "passport=DE", false},
+		{"thai too short", "AA12345", "passport=TH", true},
+		{"thai lowercase", "aa1234567", "passport=TH", true},
+		{"british letters", "AB1234567", "passport=GB", true},
+		{"no listed country", "AA1234567", "passport=US GB", true},
+		{"german vowel", "A01X00T47", "passport=DE", true},
+		{"generic too long", "AA12345678", "passport", true},
+		{"generic separator", "AA-123456", "passport", true},
+		{"empty", "", "passport=TH", true},
+		{"non-string", 123456789, "passport=US", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := v.Var(tt.value, tt.tag)
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+
+	t.Run("country from field", func(t *testing.T) {
+		type Onboarding struct {
+			Nationality    string `validate:"required,country_code"`
+			PassportNumber string `validate:"required,passport=Nationality"`
+		}
+
+		assert.NoError(t, v.Struct(Onboarding{Nationality: "TH", PassportNumber: "AA1234567"}))
+		assert.NoError(t, v.Struct(Onboarding{Nationality: "GB", PassportNumber: "123456789"}))
+		assert.Error(t, v.Struct(Onboarding{Nationality: "GB", PassportNumber: "AA1234567"}))
+		// Countries without a known format use the generic ICAO form
+		assert.NoError(t, v.Struct(Onboarding{Nationality: "LA", PassportNumber: "P1234567"}))
+		assert.Error(t, v.Struct(Onboarding{Nationality: "LA", PassportNumber: "P12"}))
+		assert.Error(t, v.Struct(Onboarding{Nationality: "", PassportNumber: "AA1234567"}))
+	})
+
+	t.Run("country field in alpha-3 or lowercase", func(t *testing.T) {
+		type Onboarding struct {
+			Nationality    string
+			PassportNumber string `validate:"passport=Nationality"`
+		}
+
+		assert.NoError(t, v.Struct(Onboarding{Nationality: "THA", PassportNumber: "AA1234567"}))
+		assert.NoError(t, v.Struct(Onboarding{Nationality: "tha", PassportNumber: "AA1234567"}))
+		assert.NoError(t, v.Struct(Onboarding{Nationality: "th", PassportNumber: "AA1234567"}))
+		assert.NoError(t, v.Struct(Onboarding{Nationality: "Gbr", PassportNumber: "123456789"}))
+		assert.Error(t, v.Struct(Onboarding{Nationality: "gbr", PassportNumber: "AA1234567"}))
+		assert.Error(t, v.Struct(Onboarding{Nationality: "Thailand", PassportNumber: "AA1234567"}))
+	})
+
+	t.Run("registered format", func(t *testing.T) {
+		custom, err := NewValidator()
+		require.NoError(t, err)
+		require.NoError(t, custom.RegisterPassportFormat("LA", `P[0-9]{7}`))
+		require.NoError(t, custom.RegisterPassportFormat("TH", `[A-Z]{2}[0-9]{7}`))
+
+		assert.NoError(t, custom.Var("P1234567", "passport=LA"))
+		assert.Error(t, custom.Var("XP1234567", "passport=LA"))
+		assert.Error(t, custom.Var("K123456", "passport=TH"))
+		// Formats are registered per Validator
+		assert.NoError(t, v.Var("K123456", "passport=TH"))
+
+		assert.Error(t, custom.RegisterPassportFormat("XX", `[0-9]{9}`))
+		assert.Error(t, custom.RegisterPassportFormat("LA", `P[0-9`))
+	})
+
+	for _, param := range []string{"LA", "Missing"} {
+		t.Run("unknown "+param+" is a config error", func(t *testing.T) {
+			err := v.Var("P1234567", "passport="+param)
+
+			var configErr *ConfigError
+			require.ErrorAs(t, err, &configErr)
+			assert.Equal(t, "passport", configErr.Tag)
+		})
+	}
+}
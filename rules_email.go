@@ -0,0 +1,277 @@
+package xvalidator
+
+import (
+	"context"
+	"errors"
+	"net"
+	"reflect"
+	"strings"
+	"time"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/go-playground/validator/v10"
+	"golang.org/x/net/idna"
+)
+
+// MXResolver looks up the mail exchangers and addresses of a domain. *net.Resolver satisfies it.
+type MXResolver interface {
+	Resolver
+	LookupMX(ctx context.Context, name string) ([]*net.MX, error)
+}
+
+// defaultMXTimeout bounds email_mx lookups when WithEmailMX gets no timeout.
+const defaultMXTimeout = 3 * time.Second
+
+// mxCacheTTL is how long email_mx remembers whether a domain accepts mail.
+const mxCacheTTL = 10 * time.Minute
+
+// mxResult is a cached email_mx answer for a domain.
+type mxResult struct {
+	acceptsMail bool
+	expires     time.Time
+}
+
+// mxChecker resolves and caches whether email domains accept mail for the email_mx rule.
+type mxChecker struct {
+	resolver MXResolver
+	timeout  time.Duration
+	cache    *lruCache[string, mxResult]
+}
+
+// newMXChecker creates an mxChecker; see WithEmailMX for the defaults.
+func newMXChecker(resolver MXResolver, timeout time.Duration) *mxChecker {
+	if resolver == nil {
+		resolver = net.DefaultResolver
+	}
+	if timeout <= 0 {
+		timeout = defaultMXTimeout
+	}
+	return &mxChecker{resolver: resolver, timeout: timeout, cache: newLRUCache[string, mxResult](1024)}
+}
+
+// isNotFound reports whether err is a definitive DNS answer that the name or record does not exist.
+func isNotFound(err error) bool {
+	var dnsErr *net.DNSError
+	return errors.As(err, &dnsErr) && dnsErr.IsNotFound
+}
+
+// acceptsMail reports whether domain has MX records, or A/AAAA records used as an implicit MX (RFC 5321).
+// A null MX (RFC 7505) means the domain does not accept mail. Lookup failures other than "not found"
+// are not cached and report false.
+func (c *mxChecker) acceptsMail(ctx context.Context, domain string) bool {
+	if cached, ok := c.cache.Get(domain); ok && time.Now().Before(cached.expires) {
+		return cached.acceptsMail
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+
+	accepts, err := c.lookup(ctx, domain)
+	if err != nil {
+		return false
+	}
+	c.cache.Add(domain, mxResult{acceptsMail: accepts, expires: time.Now().Add(mxCacheTTL)})
+	return accepts
+}
+
+// lookup queries the MX records of domain, falling back to its addresses when it has none.
+func (c *mxChecker) lookup(ctx context.Context, domain string) (bool, error) {
+	records, err := c.resolver.LookupMX(ctx, domain)
+	switch {
+	case err == nil && len(records) == 1 && records[0].Host == ".":
+		return false, nil
+	case err == nil && len(records) > 0:
+		return true, nil
+	case err != nil && !isNotFound(err):
+		return false, err
+	}
+
+	addrs, err := c.resolver.LookupIPAddr(ctx, domain)
+	switch {
+	case err == nil:
+		return len(addrs) > 0, nil
+	case isNotFound(err):
+		return false, nil
+	default:
+		return false, err
+	}
+}
+
+// emailDomain returns the domain of an email address in lowercase ASCII form, or false without one.
+func emailDomain(email string) (string, bool) {
+	i := strings.LastIndexByte(email, '@')
+	if i <= 0 || i == len(email)-1 {
+		return "", false
+	}
+	domain, err := idna.Lookup.ToASCII(strings.TrimSuffix(email[i+1:], "."))
+	if err != nil || domain == "" {
+		return "", false
+	}
+	return strings.ToLower(domain), true
+}
+
+// validateEmailMX returns a validator checking that the domain of an email address accepts mail: it has MX
+// records, or address records when it has none. Lookups use the context passed to StructCtx or VarCtx and
+// answers are cached for 10 minutes. Only the domain is checked, so combine the rule with email.
+// The rule is opt-in: without WithEmailMX it reports a ConfigError.
+// Usage:
+//   - `validate:"email,email_mx"` - catch typo domains such as "gmial.con" at sign-up
+func validateEmailMX(checker *mxChecker) validator.FuncCtx {
+	return func(ctx context.Context, fl validator.FieldLevel) bool {
+		if checker == nil {
+			panicConfigError(fl, "email_mx must be enabled with WithEmailMX")
+		}
+
+		field := fl.Field()
+		if field.Kind() != reflect.String {
+			return false
+		}
+
+		domain, ok := emailDomain(field.String())
+		if !ok {
+			return false
+		}
+		return checker.acceptsMail(ctx, domain)
+	}
+}
+
+// validateEmailDomainNotIn returns a validator rejecting email addresses whose domain, or a parent of it,
+// is in domains. Only the domain is checked, so combine the rules with email.
+// It backs email_not_disposable, using DisposableEmailDomains unless replaced with WithDisposableEmailDomains,
+// and email_business, using FreeEmailDomains unless replaced with WithFreeEmailDomains.
+// Usage:
+//   - `validate:"email,email_not_disposable"` - prevent sign-up abuse with throwaway addresses
+//   - `validate:"email,email_business"` - require a company address on B2B lead forms
+func validateEmailDomainNotIn(domains func() domainSet) validator.Func {
+	return func(fl validator.FieldLevel) bool {
+		field := fl.Field()
+		if field.Kind() != reflect.String {
+			return false
+		}
+
+		domain, ok := emailDomain(field.String())
+		return ok && !domains().contains(domain)
+	}
+}
+
+// Internationalized email validation logic functions
+
+// maxEmailLength is the longest email address in octets allowed in an SMTP path (RFC 5321 section 4.5.3.1.3).
+const maxEmailLength = 254
+
+// maxEmailLocalLength is the longest local part in octets (RFC 5321 section 4.5.3.1.1).
+const maxEmailLocalLength = 64
+
+// isEmailAtext reports whether r may appear unquoted in a local part: an RFC 5322 atext character, or
+// any printable non-ASCII character (RFC 6531 UTF8-non-ascii).
+func isEmailAtext(r rune) bool {
+	if r >= utf8.RuneSelf {
+		return unicode.IsPrint(r) && !unicode.IsSpace(r)
+	}
+	return r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9' || strings.ContainsRune("!#$%&'*+-/=?^_`{|}~", r)
+}
+
+// isEmailLocalUTF8 reports whether local is a dot-atom or quoted-string local part, with UTF-8 allowed (RFC 6531).
+func isEmailLocalUTF8(local string) bool {
+	if local == "" || len(local) > maxEmailLocalLength || !utf8.ValidString(local) {
+		return false
+	}
+
+	if len(local) >= 2 && local[0] == '"' && local[len(local)-1] == '"' {
+		quoted := local[1 : len(local)-1]
+		for i := 0; i < len(quoted); i++ {
+			switch c := quoted[i]; {
+			case c == '\\':
+				i++
+				if i == len(quoted) || quoted[i] < ' ' || quoted[i] == 0x7f {
+					return false
+				}
+			case c == '"' || c < ' ' || c == 0x7f:
+				return false
+			}
+		}
+		return true
+	}
+
+	for _, atom := range strings.Split(local, ".") {
+		if atom == "" || strings.IndexFunc(atom, func(r rune) bool { return !isEmailAtext(r) }) >= 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// isIDNLabel reports whether label is a host name label in any script: letters, digits, combining marks and
+// inner hyphens.
+func isIDNLabel(label string) bool {
+	if label == "" || strings.HasPrefix(label, "-") || strings.HasSuffix(label, "-") {
+		return false
+	}
+	return strings.IndexFunc(label, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r) && !unicode.IsMark(r) && r != '-'
+	}) < 0
+}
+
+// isEmailDomainIDN reports whether domain is a host name with at least two labels whose ASCII form fits DNS limits.
+// With punycode, the domain must also be a valid IDNA2008 name that converts to punycode (e.g., "ไทย.ไทย").
+func isEmailDomainIDN(domain string, punycode bool) bool {
+	labels := strings.Split(domain, ".")
+	if len(labels) < 2 {
+		return false
+	}
+	for _, label := range labels {
+		if !isIDNLabel(label) {
+			return false
+		}
+	}
+	if strings.IndexFunc(labels[len(labels)-1], func(r rune) bool { return !unicode.IsDigit(r) }) < 0 {
+		return false
+	}
+
+	profile := idna.Punycode
+	if punycode {
+		profile = idna.Lookup
+	}
+	ascii, err := profile.ToASCII(domain)
+	if err != nil || len(ascii) > 253 {
+		return false
+	}
+	for _, label := range strings.Split(ascii, ".") {
+		if len(label) > 63 {
+			return false
+		}
+	}
+	return true
+}
+
+// validateEmailIDN validates internationalized email addresses (RFC 6531): UTF-8 local parts such as
+// "สมชาย@example.com" and internationalized domain names such as "user@ตัวอย่าง.ไทย". The local part is a
+// dot-atom or a quoted string of at most 64 octets, domain labels hold only letters, digits, marks and inner
+// hyphens, and the address is at most 254 octets. The "punycode" parameter also requires a domain that is
+// valid under IDNA2008, so it can be converted to punycode for DNS.
+// Usage:
+//   - `validate:"email_idn"` - e.g., "ผู้ใช้@ตัวอย่าง.ไทย" or "user@example.com"
+//   - `validate:"email_idn=punycode"` - reject domains that cannot be registered or resolved
+func validateEmailIDN(fl validator.FieldLevel) bool {
+	var punycode bool
+	switch fl.Param() {
+	case "":
+	case "punycode":
+		punycode = true
+	default:
+		panicConfigError(fl, "expected no parameter or punycode")
+	}
+
+	field := fl.Field()
+	if field.Kind() != reflect.String {
+		return false
+	}
+
+	email := field.String()
+	i := strings.LastIndexByte(email, '@')
+	if i < 0 || len(email) > maxEmailLength {
+		return false
+	}
+	return isEmailLocalUTF8(email[:i]) && isEmailDomainIDN(email[i+1:], punycode)
+}
@@ -0,0 +1,293 @@
+package xvalidator
+
+import (
+	"context"
+	"net"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeMXResolver answers MX lookups from a table and address lookups from an embedded fakeResolver,
+// counting MX queries.
+type fakeMXResolver struct {
+	fakeResolver
+	mx      map[string][]string
+	failing map[string]bool
+	lookups atomic.Int32
+}
+
+func (r *fakeMXResolver) LookupMX(ctx context.Context, name string) ([]*net.MX, error) {
+	r.lookups.Add(1)
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	if r.failing[name] {
+		return nil, &net.DNSError{Err: "server misbehaving", Name: name, IsTemporary: true}
+	}
+	hosts, ok := r.mx[name]
+	if !ok {
+		return nil, &net.DNSError{Err: "no such host", Name: name, IsNotFound: true}
+	}
+	records := make([]*net.MX, 0, len(hosts))
+	for _, host := range hosts {
+		records = append(records, &net.MX{Host: host, Pref: 10})
+	}
+	return records, nil
+}
+
+func TestValidateEmailMX(t *testing.T) {
+	resolver := &fakeMXResolver{
+		fakeResolver: fakeResolver{"a-only.example": {"203.0.113.10"}},
+		mx: map[string][]string{
+			"example.com":                   {"mx1.example.com.", "mx2.example.com."},
+			"no-mail.example":               {"."},
+			"xn--72c1a1bt4awk9o.xn--o3cw4h": {"mx.xn--72c1a1bt4awk9o.xn--o3cw4h."},
+		},
+		failing: map[string]bool{"flaky.example": true},
+	}
+	v, err := NewValidator(WithEmailMX(resolver, time.Second))
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	tests := []struct {
+		name    string
+		value   any
+		wantErr bool
+	}{
+		{"domain with mx records", "somchai@example.com", false},
+		{"domain case-insensitive", "somchai@EXAMPLE.com", false},
+		{"trailing dot", "somchai@example.com.", false},
+		{"implicit mx from address records", "somchai@a-only.example", false},
+		{"null mx", "somchai@no-mail.example", true},
+		{"typo domain", "somchai@exmaple.com", true},
+		{"temporary failure", "somchai@flaky.example", true},
+		{"internationalized domain", "somchai@ตัวอย่าง.ไทย", false},
+		{"missing domain", "somchai@", true},
+		{"missing at sign", "somchai.example.com", true},
+		{"non-string", 42, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := v.VarCtx(ctx, tt.value, "email_mx")
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+
+	t.Run("answers are cached per domain", func(t *testing.T) {
+		before := resolver.lookups.Load()
+		require.NoError(t, v.VarCtx(ctx, "nok@example.com", "email_mx"))
+		require.Error(t, v.VarCtx(ctx, "nok@exmaple.com", "email_mx"))
+		assert.Equal(t, before, resolver.lookups.Load())
+
+		// Temporary failures are retried
+		require.Error(t, v.VarCtx(ctx, "nok@flaky.example", "email_mx"))
+		assert.Equal(t, before+1, resolver.lookups.Load())
+	})
+
+	t.Run("translated error", func(t *testing.T) {
+		err := v.VarTranslatedCtx(ctx, "somchai@exmaple.com", "email_mx")
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "must be an email address at a domain that accepts mail")
+	})
+
+	t.Run("not enabled is a config error", func(t *testing.T) {
+		plain, err := NewValidator()
+		require.NoError(t, err)
+
+		err = plain.VarCtx(ctx, "somchai@example.com", "email_mx")
+		var configErr *ConfigError
+		require.ErrorAs(t, err, &configErr)
+		assert.Equal(t, "email_mx", configErr.Tag)
+	})
+}
+
+func TestValidateEmailNotDisposable(t *testing.T) {
+	v, err := NewValidator()
+	require.NoError(t, err)
+
+	tests := []struct {
+		name    string
+		email   string
+		wantErr bool
+	}{
+		{"regular provider", "someone@gmail.com", false},
+		{"company domain", "jane.doe@example.co.th", false},
+		{"listed provider", "someone@mailinator.com", true},
+		{"listed provider in uppercase", "Someone@MAILINATOR.COM", true},
+		{"subdomain of listed provider", "someone@inbox.mailinator.com", true},
+		{"trailing dot", "someone@mailinator.com.", true},
+		{"lookalike domain", "someone@mailinator.example.com", false},
+		{"missing domain", "someone@", true},
+		{"missing at sign", "someone", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := v.Var(tt.email, "email_not_disposable")
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+
+	t.Run("embedded list", func(t *testing.T) {
+		domains := DisposableEmailDomains()
+		assert.Contains(t, domains, "mailinator.com")
+		assert.NotContains(t, domains, "gmail.com")
+	})
+
+	t.Run("extended list", func(t *testing.T) {
+		extended, err := NewValidator(WithDisposableEmailDomains(append(DisposableEmailDomains(), "Throwaway.Example")))
+		require.NoError(t, err)
+
+		assert.Error(t, extended.Var("someone@throwaway.example", "email_not_disposable"))
+		assert.Error(t, extended.Var("someone@mailinator.com", "email_not_disposable"))
+		assert.NoError(t, v.Var("someone@throwaway.example", "email_not_disposable"))
+	})
+
+	t.Run("replaced list", func(t *testing.T) {
+		replaced, err := NewValidator(WithDisposableEmailDomains([]string{"throwaway.example"}))
+		require.NoError(t, err)
+
+		assert.NoError(t, replaced.Var("someone@mailinator.com", "email_not_disposable"))
+		assert.Error(t, replaced.Var("someone@throwaway.example", "email_not_disposable"))
+	})
+}
+
+func TestValidateEmailBusiness(t *testing.T) {
+	v, err := NewValidator()
+	require.NoError(t, err)
+
+	tests := []struct {
+		name    string
+		email   string
+		wantErr bool
+	}{
+		{"company domain", "jane.doe@example.co.th", false},
+		{"company subdomain", "jane@sales.example.com", false},
+		{"gmail", "someone@gmail.com", true},
+		{"hotmail in uppercase", "Someone@Hotmail.COM", true},
+		{"regional provider", "someone@yahoo.co.th", true},
+		{"missing domain", "someone@", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := v.Var(tt.email, "email_business")
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+
+	t.Run("injected providers", func(t *testing.T) {
+		custom, err := NewValidator(WithFreeEmailDomains(append(FreeEmailDomains(), "freemail.example")))
+		require.NoError(t, err)
+
+		assert.Error(t, custom.Var("someone@freemail.example", "email_business"))
+		assert.Error(t, custom.Var("someone@gmail.com", "email_business"))
+		assert.NoError(t, v.Var("someone@freemail.example", "email_business"))
+	})
+}
+
+func TestNormalizeEmail(t *testing.T) {
+	tests := []struct {
+		email string
+		want  string
+	}{
+		{"  Jane.Doe@Example.COM ", "Jane.Doe@example.com"},
+		{"someone@example.com.", "someone@example.com"},
+		{"First.Last+news@GMAIL.com", "First.Last+news@gmail.com"},
+		{"not-an-email ", "not-an-email"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.email, func(t *testing.T) {
+			assert.Equal(t, tt.want, NormalizeEmail(tt.email))
+		})
+	}
+}
+
+func TestCanonicalEmail(t *testing.T) {
+	tests := []struct {
+		email string
+		want  string
+	}{
+		{"First.Last+news@GMAIL.com", "firstlast@gmail.com"},
+		{"f.i.r.s.t.last@googlemail.com", "firstlast@gmail.com"},
+		{"Jane.Doe+shop@Outlook.com", "jane.doe@outlook.com"},
+		{"jane+work@icloud.com", "jane@icloud.com"},
+		{"Jane.Doe+Tag@Example.COM", "Jane.Doe+Tag@example.com"},
+		{"+tag@gmail.com", "+tag@gmail.com"},
+		{" not-an-email ", "not-an-email"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.email, func(t *testing.T) {
+			assert.Equal(t, tt.want, CanonicalEmail(tt.email))
+		})
+	}
+}
+
+func TestValidateEmailIDN(t *testing.T) {
+	v, err := NewValidator()
+	require.NoError(t, err)
+
+	tests := []struct {
+		name    string
+		email   string
+		tag     string
+		wantErr bool
+	}{
+		{"ascii address", "user@example.com", "email_idn", false},
+		{"thai domain", "user@ตัวอย่าง.ไทย", "email_idn", false},
+		{"thai local part", "ผู้ใช้@ตัวอย่าง.ไทย", "email_idn", false},
+		{"chinese address", "用户@例子.广告", "email_idn", false},
+		{"punycode domain", "user@xn--72c1a1bt4awk9o.xn--o3cw4h", "email_idn", false},
+		{"quoted local part", `"john doe"@example.com`, "email_idn", false},
+		{"dotted local part", "first.last+tag@example.com", "email_idn", false},
+		{"thai address with punycode", "ผู้ใช้@ตัวอย่าง.ไทย", "email_idn=punycode", false},
+		{"missing at sign", "ผู้ใช้.ตัวอย่าง.ไทย", "email_idn", true},
+		{"single label domain", "ผู้ใช้@ตัวอย่าง", "email_idn", true},
+		{"numeric top-level domain", "user@example.123", "email_idn", true},
+		{"leading dot", ".user@example.com", "email_idn", true},
+		{"consecutive dots", "us..er@example.com", "email_idn", true},
+		{"unquoted space", "john doe@example.com", "email_idn", true},
+		{"symbol in domain", "user@exa★mple.com", "email_idn", true},
+		{"hyphen at label end", "user@example-.com", "email_idn", true},
+		{"local part too long", strings.Repeat("ก", 22) + "@example.com", "email_idn", true},
+		{"invalid IDNA2008 label with punycode", "user@xn--ab-.com", "email_idn=punycode", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := v.Var(tt.email, tt.tag)
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+
+	t.Run("invalid parameter is a config error", func(t *testing.T) {
+		err := v.Var("user@example.com", "email_idn=strict")
+		var configErr *ConfigError
+		require.ErrorAs(t, err, &configErr)
+		assert.Equal(t, "email_idn", configErr.Tag)
+	})
+}
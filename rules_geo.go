@@ -0,0 +1,243 @@
+package xvalidator
+
+import (
+	"encoding/json"
+	"strconv"
+	"strings"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/shopspring/decimal"
+)
+
+// Coordinate validation logic functions
+
+// defaultCoordinateScale is the number of decimal places allowed when latitude_str or longitude_str has no
+// parameter; 8 places resolve about one millimeter and fit DECIMAL(10,8) and DECIMAL(11,8) columns.
+const defaultCoordinateScale = 8
+
+// validateCoordinate returns a validator for decimal strings whose absolute value is at most limit, with at most
+// the number of decimal places given by the parameter (default 8). Like the decimal rule, exponents and
+// whitespace are rejected and trailing fractional zeros do not count towards the scale, so coordinates are
+// validated as written instead of through a float64.
+// Usage:
+//   - `validate:"latitude_str"` - e.g., "13.7563309" (between -90 and 90)
+//   - `validate:"longitude_str=6"` - e.g., "100.501765" (between -180 and 180, at most 6 decimal places)
+func validateCoordinate(limit int64) validator.Func {
+	maxValue := decimal.NewFromInt(limit)
+	return func(fl validator.FieldLevel) bool {
+		scale := int32(defaultCoordinateScale)
+		if param := fl.Param(); param != "" {
+			n, err := strconv.ParseInt(param, 10, 32)
+			if err != nil || n < 0 {
+				panicConfigError(fl, "expected a maximum number of decimal places")
+			}
+			scale = int32(n)
+		}
+
+		data, ok := decimalFieldString(fl.Field())
+		if !ok {
+			return false
+		}
+		if _, decimalPlaces, ok := scanDecimalDigits(data); !ok || decimalPlaces > scale {
+			return false
+		}
+
+		value, err := decimal.NewFromString(data)
+		return err == nil && value.Abs().LessThanOrEqual(maxValue)
+	}
+}
+
+// GeoJSON validation logic functions
+
+// geoJSONGeometryTypes are the geometry types of RFC 7946 section 1.4.
+var geoJSONGeometryTypes = map[string]bool{
+	"Point": true, "MultiPoint": true, "LineString": true, "MultiLineString": true,
+	"Polygon": true, "MultiPolygon": true, "GeometryCollection": true,
+}
+
+// isGeoJSONPosition reports whether value is a position: an array of two or more numbers (RFC 7946 section 3.1.1).
+func isGeoJSONPosition(value any) bool {
+	numbers, ok := value.([]any)
+	if !ok || len(numbers) < 2 {
+		return false
+	}
+	for _, number := range numbers {
+		if _, ok := number.(float64); !ok {
+			return false
+		}
+	}
+	return true
+}
+
+// geoJSONPositions returns value as an array of at least minCount positions.
+func geoJSONPositions(value any, minCount int) ([]any, bool) {
+	positions, ok := value.([]any)
+	if !ok || len(positions) < minCount {
+		return nil, false
+	}
+	for _, position := range positions {
+		if !isGeoJSONPosition(position) {
+			return nil, false
+		}
+	}
+	return positions, true
+}
+
+// isGeoJSONLineString reports whether value holds the coordinates of a LineString: two or more positions.
+func isGeoJSONLineString(value any) bool {
+	_, ok := geoJSONPositions(value, 2)
+	return ok
+}
+
+// isGeoJSONPolygon reports whether value holds the coordinates of a Polygon: linear rings of four or more
+// positions whose first and last positions are equal (RFC 7946 section 3.1.6).
+func isGeoJSONPolygon(value any) bool {
+	rings, ok := value.([]any)
+	if !ok {
+		return false
+	}
+	for _, ring := range rings {
+		positions, ok := geoJSONPositions(ring, 4)
+		if !ok {
+			return false
+		}
+		first, last := positions[0].([]any), positions[len(positions)-1].([]any)
+		if len(first) != len(last) {
+			return false
+		}
+		for i := range first {
+			if first[i] != last[i] {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// isGeoJSONArrayOf reports whether value is an array whose elements all satisfy valid.
+func isGeoJSONArrayOf(value any, valid func(any) bool) bool {
+	elements, ok := value.([]any)
+	if !ok {
+		return false
+	}
+	for _, element := range elements {
+		if !valid(element) {
+			return false
+		}
+	}
+	return true
+}
+
+// isGeoJSONBBox reports whether an object has no bbox member or a valid one: 2*n numbers for n >= 2 dimensions.
+func isGeoJSONBBox(object map[string]any) bool {
+	bbox, found := object["bbox"]
+	if !found {
+		return true
+	}
+	numbers, ok := bbox.([]any)
+	return ok && len(numbers) >= 4 && len(numbers)%2 == 0 && isGeoJSONPosition(numbers)
+}
+
+// isGeoJSONGeometry reports whether value is a geometry object whose type is in allowed, when allowed is not nil.
+// The geometries of a GeometryCollection must be allowed too.
+func isGeoJSONGeometry(value any, allowed map[string]bool) bool {
+	object, ok := value.(map[string]any)
+	if !ok || !isGeoJSONBBox(object) {
+		return false
+	}
+	geometryType, _ := object["type"].(string)
+	if !geoJSONGeometryTypes[geometryType] || (allowed != nil && !allowed[geometryType]) {
+		return false
+	}
+
+	coordinates := object["coordinates"]
+	switch geometryType {
+	case "Point":
+		return isGeoJSONPosition(coordinates)
+	case "MultiPoint":
+		_, ok := geoJSONPositions(coordinates, 0)
+		return ok
+	case "LineString":
+		return isGeoJSONLineString(coordinates)
+	case "MultiLineString":
+		return isGeoJSONArrayOf(coordinates, isGeoJSONLineString)
+	case "Polygon":
+		return isGeoJSONPolygon(coordinates)
+	case "MultiPolygon":
+		return isGeoJSONArrayOf(coordinates, isGeoJSONPolygon)
+	default:
+		return isGeoJSONArrayOf(object["geometries"], func(geometry any) bool {
+			return isGeoJSONGeometry(geometry, allowed)
+		})
+	}
+}
+
+// isGeoJSONFeature reports whether value is a Feature object with a geometry, or a null geometry when
+// allowed is nil, and a properties member holding an object or null (RFC 7946 section 3.2).
+func isGeoJSONFeature(value any, allowed map[string]bool) bool {
+	object, ok := value.(map[string]any)
+	if !ok || object["type"] != "Feature" || !isGeoJSONBBox(object) {
+		return false
+	}
+
+	geometry, found := object["geometry"]
+	if !found || (geometry == nil && allowed != nil) || (geometry != nil && !isGeoJSONGeometry(geometry, allowed)) {
+		return false
+	}
+	properties, found := object["properties"]
+	if _, isObject := properties.(map[string]any); !found || (properties != nil && !isObject) {
+		return false
+	}
+	return true
+}
+
+// isGeoJSON reports whether value is a GeoJSON object: a geometry, a Feature or a FeatureCollection.
+func isGeoJSON(value any, allowed map[string]bool) bool {
+	object, ok := value.(map[string]any)
+	if !ok {
+		return false
+	}
+
+	switch object["type"] {
+	case "Feature":
+		return isGeoJSONFeature(object, allowed)
+	case "FeatureCollection":
+		return isGeoJSONBBox(object) && isGeoJSONArrayOf(object["features"], func(feature any) bool {
+			return isGeoJSONFeature(feature, allowed)
+		})
+	default:
+		return isGeoJSONGeometry(object, allowed)
+	}
+}
+
+// validateGeoJSON validates that a string or byte slice field (such as json.RawMessage) holds a structurally
+// valid GeoJSON object (RFC 7946): a geometry, a Feature or a FeatureCollection, with well-formed positions,
+// closed linear rings of at least four positions and the required Feature members. The parameter lists the
+// geometry types allowed anywhere in the document; Features then need a geometry.
+// Coordinate ranges and ring winding order are not checked.
+// Usage:
+//   - `validate:"geojson"` - e.g., `{"type":"Point","coordinates":[100.5018,13.7563]}`
+//   - `validate:"geojson=Polygon MultiPolygon"` - delivery zones, bare or wrapped in Features
+func validateGeoJSON(fl validator.FieldLevel) bool {
+	var allowed map[string]bool
+	if param := fl.Param(); param != "" {
+		allowed = make(map[string]bool)
+		for _, geometryType := range strings.Fields(param) {
+			if !geoJSONGeometryTypes[geometryType] {
+				panicConfigError(fl, "expected GeoJSON geometry types such as Polygon or MultiPolygon")
+			}
+			allowed[geometryType] = true
+		}
+	}
+
+	content, ok := fieldBytes(fl.Field())
+	if !ok {
+		return false
+	}
+
+	var value any
+	if err := json.Unmarshal(content, &value); err != nil {
+		return false
+	}
+	return isGeoJSON(value, allowed)
+}
@@ -0,0 +1,133 @@
+package xvalidator
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateCoordinate(t *testing.T) {
+	v, err := NewValidator()
+	require.NoError(t, err)
+
+	tests := []struct {
+		name    string
+		value   any
+		tag     string
+		wantErr bool
+	}{
+		{"latitude", "13.7563309", "latitude_str", false},
+		{"negative latitude", "-33.8688", "latitude_str", false},
+		{"latitude bound", "-90", "latitude_str", false},
+		{"latitude bound with zeros", "90.000000", "latitude_str", false},
+		{"integer latitude", "0", "latitude_str", false},
+		{"longitude", "100.5017651", "longitude_str", false},
+		{"longitude bound", "180.0", "longitude_str", false},
+		{"longitude within custom scale", "-151.209", "longitude_str=3", false},
+		{"trailing zeros beyond scale", "100.5000", "longitude_str=1", false},
+		{"json number", json.Number("13.75"), "latitude_str", false},
+		{"latitude out of range", "90.0000001", "latitude_str", true},
+		{"longitude as latitude", "100.5", "latitude_str", true},
+		{"longitude out of range", "-180.01", "longitude_str", true},
+		{"too many decimal places", "13.756330912", "latitude_str", true},
+		{"custom scale exceeded", "100.5018", "longitude_str=3", true},
+		{"exponent", "1.3e1", "latitude_str", true},
+		{"whitespace", " 13.75", "latitude_str", true},
+		{"degrees minutes seconds", "13°45'22\"N", "latitude_str", true},
+		{"comma decimal separator", "13,75", "latitude_str", true},
+		{"empty", "", "latitude_str", true},
+		{"float field", 13.75, "latitude_str", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := v.Var(tt.value, tt.tag)
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+
+	t.Run("invalid scale is a config error", func(t *testing.T) {
+		err := v.Var("13.75", "latitude_str=max")
+
+		var configErr *ConfigError
+		require.ErrorAs(t, err, &configErr)
+		assert.Equal(t, "latitude_str", configErr.Tag)
+	})
+}
+
+func TestValidateGeoJSON(t *testing.T) {
+	v, err := NewValidator()
+	require.NoError(t, err)
+
+	const (
+		point   = `{"type":"Point","coordinates":[100.5018,13.7563]}`
+		polygon = `{"type":"Polygon","coordinates":[[[100.0,13.0],[101.0,13.0],[101.0,14.0],[100.0,13.0]]]}`
+	)
+
+	tests := []struct {
+		name    string
+		value   any
+		tag     string
+		wantErr bool
+	}{
+		{"point", point, "geojson", false},
+		{"point with altitude", `{"type":"Point","coordinates":[100.5,13.7,12.5]}`, "geojson", false},
+		{"raw message", json.RawMessage(point), "geojson", false},
+		{"line string", `{"type":"LineString","coordinates":[[100,13],[101,14]]}`, "geojson", false},
+		{"multi point", `{"type":"MultiPoint","coordinates":[]}`, "geojson", false},
+		{"polygon", polygon, "geojson", false},
+		{"polygon with bbox", `{"type":"Polygon","bbox":[100,13,101,14],"coordinates":[[[100,13],[101,13],[101,14],[100,13]]]}`, "geojson", false},
+		{"multi polygon", `{"type":"MultiPolygon","coordinates":[[[[100,13],[101,13],[101,14],[100,13]]]]}`, "geojson", false},
+		{"geometry collection", `{"type":"GeometryCollection","geometries":[` + point + `,` + polygon + `]}`, "geojson", false},
+		{"feature", `{"type":"Feature","geometry":` + polygon + `,"properties":{"zone":"A"}}`, "geojson", false},
+		{"feature with null geometry", `{"type":"Feature","geometry":null,"properties":null}`, "geojson", false},
+		{"feature collection", `{"type":"FeatureCollection","features":[{"type":"Feature","geometry":` + polygon + `,"properties":{}}]}`, "geojson", false},
+		{"allowed geometry", polygon, "geojson=Polygon MultiPolygon", false},
+		{"allowed geometry in features", `{"type":"FeatureCollection","features":[{"type":"Feature","geometry":` + polygon + `,"properties":{}}]}`, "geojson=Polygon", false},
+		{"invalid json", `{"type":"Point",`, "geojson", true},
+		{"not an object", `[100.5,13.7]`, "geojson", true},
+		{"unknown type", `{"type":"Circle","coordinates":[100.5,13.7]}`, "geojson", true},
+		{"type is case-sensitive", `{"type":"point","coordinates":[100.5,13.7]}`, "geojson", true},
+		{"missing coordinates", `{"type":"Point"}`, "geojson", true},
+		{"one-number position", `{"type":"Point","coordinates":[100.5]}`, "geojson", true},
+		{"string coordinates", `{"type":"Point","coordinates":["100.5","13.7"]}`, "geojson", true},
+		{"one-position line string", `{"type":"LineString","coordinates":[[100,13]]}`, "geojson", true},
+		{"open ring", `{"type":"Polygon","coordinates":[[[100,13],[101,13],[101,14],[100,14]]]}`, "geojson", true},
+		{"short ring", `{"type":"Polygon","coordinates":[[[100,13],[101,13],[100,13]]]}`, "geojson", true},
+		{"invalid bbox", `{"type":"Point","bbox":[100,13,101],"coordinates":[100.5,13.7]}`, "geojson", true},
+		{"feature without properties", `{"type":"Feature","geometry":` + point + `}`, "geojson", true},
+		{"feature with invalid geometry", `{"type":"Feature","geometry":{"type":"Point"},"properties":{}}`, "geojson", true},
+		{"feature collection of geometries", `{"type":"FeatureCollection","features":[` + point + `]}`, "geojson", true},
+		{"disallowed geometry", point, "geojson=Polygon MultiPolygon", true},
+		{"disallowed geometry in feature", `{"type":"Feature","geometry":` + point + `,"properties":{}}`, "geojson=Polygon", true},
+		{"disallowed geometry in collection", `{"type":"GeometryCollection","geometries":[` + point + `]}`, "geojson=GeometryCollection Polygon", true},
+		{"null geometry with allowed types", `{"type":"Feature","geometry":null,"properties":{}}`, "geojson=Polygon", true},
+		{"empty", "", "geojson", true},
+		{"unsupported field type", 42, "geojson", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := v.Var(tt.value, tt.tag)
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+
+	t.Run("unknown geometry type is a config error", func(t *testing.T) {
+		err := v.Var(point, "geojson=Circle")
+
+		var configErr *ConfigError
+		require.ErrorAs(t, err, &configErr)
+		assert.Equal(t, "geojson", configErr.Tag)
+	})
+}
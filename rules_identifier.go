@@ -0,0 +1,319 @@
+package xvalidator
+
+import (
+	"math"
+	"math/big"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/go-playground/validator/v10"
+)
+
+// parseMaxLengthParam parses the optional maximum length parameter of a rule; 0 means no limit.
+func parseMaxLengthParam(fl validator.FieldLevel) int {
+	if fl.Param() == "" {
+		return 0
+	}
+	maxLength, err := strconv.Atoi(fl.Param())
+	if err != nil || maxLength <= 0 {
+		panicConfigError(fl, "expected a positive maximum length")
+	}
+	return maxLength
+}
+
+// validateSlug validates lowercase URL slugs: ASCII letters and digits in words joined by single hyphens,
+// with an optional maximum length.
+// Usage:
+//   - `validate:"slug"` - e.g., "hello-world-2026"
+//   - `validate:"slug=64"` - at most 64 characters
+func validateSlug(fl validator.FieldLevel) bool {
+	maxLength := parseMaxLengthParam(fl)
+
+	field := fl.Field()
+	if field.Kind() != reflect.String {
+		return false
+	}
+
+	slug := field.String()
+	if maxLength > 0 && len(slug) > maxLength {
+		return false
+	}
+	return SlugRegex().MatchString(slug)
+}
+
+// validateSemver validates SemVer 2.0.0 version strings such as "1.4.0-rc.1+build.5", without a "v" prefix.
+// An optional range parameter restricts the version: comparators (=, !=, >, >=, <, <=), caret and tilde
+// ranges and wildcards, combined with spaces (AND) and "||" (OR, written 0x7C0x7C inside struct tags).
+// Pre-release versions only satisfy ranges that name a pre-release of the same version.
+// It replaces go-playground/validator's semver rule, which takes no parameter; unlike that rule, major, minor
+// and patch numbers must fit in a uint64.
+// Usage:
+//   - `validate:"semver"` - e.g., "2.0.0" or "1.0.0-alpha.1"
+//   - `validate:"semver=^1.0.0"` - 1.x releases from 1.0.0
+//   - `validate:"semver=>=1.2.0 <3.0.0"` - explicit bounds
+//   - `validate:"semver=~1.2.0 0x7C0x7C ^2.0.0"` - 1.2.x from 1.2.0, or 2.x
+func validateSemver(fl validator.FieldLevel) bool {
+	var constraint semverConstraint
+	if param := fl.Param(); param != "" {
+		var ok bool
+		if constraint, ok = parseSemverConstraint(param); !ok {
+			panicConfigError(fl, "expected a version range such as ^1.0.0 or >=1.2.0 <2.0.0")
+		}
+	}
+
+	field := fl.Field()
+	if field.Kind() != reflect.String {
+		return false
+	}
+
+	version, ok := parseSemver(field.String())
+	return ok && (constraint == nil || constraint.matches(version))
+}
+
+// defaultNanoIDLength is the length of NanoIDs generated with the default settings.
+const defaultNanoIDLength = 21
+
+// nanoIDAlphabet is the default URL-safe NanoID alphabet.
+const nanoIDAlphabet = "ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz0123456789_-"
+
+// parseNanoIDParam parses a nanoid parameter: a length (default 21), optionally followed by a colon and a
+// custom alphabet.
+func parseNanoIDParam(param string) (length int, alphabet string, ok bool) {
+	lengthText, alphabet, _ := strings.Cut(param, ":")
+	if alphabet == "" {
+		alphabet = nanoIDAlphabet
+	}
+	if lengthText == "" {
+		return defaultNanoIDLength, alphabet, true
+	}
+	length, err := strconv.Atoi(lengthText)
+	return length, alphabet, err == nil && length > 0
+}
+
+// validateNanoID validates NanoID strings of an exact length, made of the default URL-safe alphabet
+// (A-Z, a-z, 0-9, "_" and "-") or a custom alphabet given after the length.
+// Usage:
+//   - `validate:"nanoid"` - 21 characters, e.g., "V1StGXR8_Z5jdHi6B-myT"
+//   - `validate:"nanoid=10"` - 10 characters
+//   - `validate:"nanoid=12:0123456789abcdef"` - 12 lowercase hex characters
+func validateNanoID(fl validator.FieldLevel) bool {
+	length, alphabet, ok := parseNanoIDParam(fl.Param())
+	if !ok {
+		panicConfigError(fl, "expected a positive length, optionally followed by :alphabet")
+	}
+
+	field := fl.Field()
+	if field.Kind() != reflect.String {
+		return false
+	}
+
+	id := field.String()
+	if utf8.RuneCountInString(id) != length {
+		return false
+	}
+	return strings.IndexFunc(id, func(r rune) bool { return !strings.ContainsRune(alphabet, r) }) < 0
+}
+
+// Sortable ID validation logic functions
+
+// maxIDClockSkew is how far in the future the timestamp of an ID may lie when a rule checks it.
+const maxIDClockSkew = time.Minute
+
+// ksuidEpoch is the KSUID epoch, 2014-05-13T16:53:20Z, in Unix seconds.
+const ksuidEpoch = 1400000000
+
+// maxKSUID is the largest KSUID, the base62 encoding of 2^160-1.
+const maxKSUID = "aWgEPTl1tmebfsQzFP4bxwgy80V"
+
+// snowflakeEpochs maps named Snowflake epochs to Unix milliseconds.
+var snowflakeEpochs = map[string]int64{
+	"twitter": 1288834974657,
+	"discord": 1420070400000,
+}
+
+// crockfordBase32 is the Crockford base32 alphabet used by ULIDs (no I, L, O or U).
+const crockfordBase32 = "0123456789ABCDEFGHJKMNPQRSTVWXYZ"
+
+// base62Alphabet is the base62 alphabet used by KSUIDs, in ASCII order.
+const base62Alphabet = "0123456789ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz"
+
+// parseIDTimeParam parses the optional "time" parameter of the ulid and ksuid rules.
+func parseIDTimeParam(fl validator.FieldLevel) bool {
+	switch fl.Param() {
+	case "":
+		return false
+	case "time":
+		return true
+	default:
+		panicConfigError(fl, "expected no parameter or time")
+		return false
+	}
+}
+
+// notInFuture reports whether t lies no later than the current time according to now, plus maxIDClockSkew.
+func notInFuture(t time.Time, now func() time.Time) bool {
+	return !t.After(now().Add(maxIDClockSkew))
+}
+
+// ulidTime returns the timestamp of a ULID: its first 10 characters encode Unix milliseconds.
+// It reports false when id is not a ULID. Lowercase letters are accepted, as the encoding is case-insensitive.
+func ulidTime(id string) (time.Time, bool) {
+	// The first character is at most 7, so the 48-bit timestamp does not overflow
+	if len(id) != 26 || id[0] > '7' {
+		return time.Time{}, false
+	}
+
+	var millis int64
+	for i := 0; i < len(id); i++ {
+		digit := strings.IndexByte(crockfordBase32, upperASCII(id[i]))
+		if digit < 0 {
+			return time.Time{}, false
+		}
+		if i < 10 {
+			millis = millis<<5 | int64(digit)
+		}
+	}
+	return time.UnixMilli(millis), true
+}
+
+// upperASCII converts an ASCII lowercase letter to uppercase.
+func upperASCII(c byte) byte {
+	if c >= 'a' && c <= 'z' {
+		return c - ('a' - 'A')
+	}
+	return c
+}
+
+// ksuidTime returns the timestamp of a KSUID: the top 32 bits of its 160-bit value count seconds since the
+// KSUID epoch. It reports false when id is not a KSUID.
+func ksuidTime(id string) (time.Time, bool) {
+	// Fixed-length base62 strings in ASCII order compare like the numbers they encode
+	if len(id) != len(maxKSUID) || id > maxKSUID {
+		return time.Time{}, false
+	}
+
+	value := new(big.Int)
+	base := big.NewInt(62)
+	for i := 0; i < len(id); i++ {
+		digit := strings.IndexByte(base62Alphabet, id[i])
+		if digit < 0 {
+			return time.Time{}, false
+		}
+		value.Mul(value, base).Add(value, big.NewInt(int64(digit)))
+	}
+	seconds := value.Rsh(value, 128).Int64()
+	return time.Unix(ksuidEpoch+seconds, 0), true
+}
+
+// validateULID returns a validator for ULIDs: 26 Crockford base32 characters holding a 48-bit millisecond
+// timestamp and 80 random bits. With the "time" parameter, the timestamp must not lie in the future
+// (allowing one minute of clock skew) according to now. It replaces go-playground/validator's ulid rule,
+// which also accepts first characters 8 to Z, whose timestamps overflow 48 bits.
+// Usage:
+//   - `validate:"ulid"` - e.g., "01ARZ3NDEKTSV4RRFFQ69G5FAV"
+//   - `validate:"ulid=time"` - also reject IDs from the future
+func validateULID(now func() time.Time) validator.Func {
+	return func(fl validator.FieldLevel) bool {
+		checkTime := parseIDTimeParam(fl)
+
+		field := fl.Field()
+		if field.Kind() != reflect.String {
+			return false
+		}
+
+		t, ok := ulidTime(field.String())
+		return ok && (!checkTime || notInFuture(t, now))
+	}
+}
+
+// validateKSUID returns a validator for KSUIDs: 27 base62 characters holding a 32-bit timestamp in seconds
+// since 2014-05-13 and 128 random bits. With the "time" parameter, the timestamp must not lie in the future
+// (allowing one minute of clock skew) according to now.
+// Usage:
+//   - `validate:"ksuid"` - e.g., "0ujtsYcgvSTl8PAuAdqWYSMnLOv"
+//   - `validate:"ksuid=time"` - also reject IDs from the future
+func validateKSUID(now func() time.Time) validator.Func {
+	return func(fl validator.FieldLevel) bool {
+		checkTime := parseIDTimeParam(fl)
+
+		field := fl.Field()
+		if field.Kind() != reflect.String {
+			return false
+		}
+
+		t, ok := ksuidTime(field.String())
+		return ok && (!checkTime || notInFuture(t, now))
+	}
+}
+
+// snowflakeParams holds the parsed snowflake rule parameter.
+type snowflakeParams struct {
+	epoch     int64 // Unix milliseconds
+	checkTime bool
+}
+
+// parseSnowflakeParam parses a snowflake parameter: space-separated "time" and an epoch, either "twitter"
+// (the default), "discord" or Unix milliseconds.
+func parseSnowflakeParam(param string) (snowflakeParams, bool) {
+	params := snowflakeParams{epoch: snowflakeEpochs["twitter"]}
+	for _, option := range strings.Fields(param) {
+		if option == "time" {
+			params.checkTime = true
+		} else if epoch, ok := snowflakeEpochs[option]; ok {
+			params.epoch = epoch
+		} else if epoch, err := strconv.ParseInt(option, 10, 64); err == nil && epoch >= 0 {
+			params.epoch = epoch
+		} else {
+			return snowflakeParams{}, false
+		}
+	}
+	return params, true
+}
+
+// snowflakeValue returns the ID held by a decimal string or integer field.
+func snowflakeValue(field reflect.Value) (int64, bool) {
+	switch field.Kind() {
+	case reflect.String:
+		s := field.String()
+		if s == "" || s[0] == '+' || (len(s) > 1 && s[0] == '0') {
+			return 0, false
+		}
+		id, err := strconv.ParseInt(s, 10, 64)
+		return id, err == nil
+	case reflect.Int, reflect.Int64:
+		return field.Int(), true
+	case reflect.Uint, reflect.Uint64:
+		if field.Uint() > math.MaxInt64 {
+			return 0, false
+		}
+		return int64(field.Uint()), true
+	default:
+		return 0, false
+	}
+}
+
+// validateSnowflake returns a validator for Snowflake IDs: positive 63-bit integers, as decimal strings
+// or integer fields, whose top 41 bits count milliseconds since an epoch. With "time", the timestamp must not
+// lie in the future (allowing one minute of clock skew) according to now; the epoch defaults to Twitter's
+// and may be "discord" or Unix milliseconds.
+// Usage:
+//   - `validate:"snowflake"` - e.g., "1541815603606036480"
+//   - `validate:"snowflake=discord time"` - Discord IDs, rejecting IDs from the future
+//   - `validate:"snowflake=1577836800000 time"` - custom epoch (2020-01-01)
+func validateSnowflake(now func() time.Time) validator.Func {
+	return func(fl validator.FieldLevel) bool {
+		params, ok := parseSnowflakeParam(fl.Param())
+		if !ok {
+			panicConfigError(fl, "expected time and an epoch: twitter, discord or Unix milliseconds")
+		}
+
+		id, ok := snowflakeValue(fl.Field())
+		if !ok || id <= 0 {
+			return false
+		}
+		return !params.checkTime || notInFuture(time.UnixMilli(params.epoch+id>>22), now)
+	}
+}
@@ -0,0 +1,257 @@
+package xvalidator
+
+import (
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateSlug(t *testing.T) {
+	v, err := NewValidator()
+	require.NoError(t, err)
+
+	tests := []struct {
+		name    string
+		value   string
+		tag     string
+		wantErr bool
+	}{
+		{"single word", "hello", "slug", false},
+		{"words and digits", "hello-world-2026", "slug", false},
+		{"digits only", "2026", "slug", false},
+		{"within max length", "hello-world", "slug=11", false},
+		{"long slug without max length", strings.Repeat("a", 300), "slug", false},
+		{"empty", "", "slug", true},
+		{"uppercase", "Hello-World", "slug", true},
+		{"underscore", "hello_world", "slug", true},
+		{"space", "hello world", "slug", true},
+		{"leading hyphen", "-hello", "slug", true},
+		{"trailing hyphen", "hello-", "slug", true},
+		{"double hyphen", "hello--world", "slug", true},
+		{"non-ASCII letters", "สวัสดี", "slug", true},
+		{"exceeds max length", "hello-world", "slug=10", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := v.Var(tt.value, tt.tag)
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+
+	t.Run("invalid max length is a config error", func(t *testing.T) {
+		err := v.Var("hello", "slug=0")
+		var configErr *ConfigError
+		require.ErrorAs(t, err, &configErr)
+		assert.Equal(t, "slug", configErr.Tag)
+	})
+}
+
+func TestValidateSemver(t *testing.T) {
+	v, err := NewValidator()
+	require.NoError(t, err)
+
+	tests := []struct {
+		name    string
+		value   string
+		tag     string
+		wantErr bool
+	}{
+		{"release", "1.2.3", "semver", false},
+		{"zero version", "0.0.0", "semver", false},
+		{"largest number", "18446744073709551615.0.0", "semver", false},
+		{"number overflows uint64", "1.18446744073709551616.0", "semver", true},
+		{"pre-release", "1.0.0-alpha.1", "semver", false},
+		{"pre-release with hyphen", "1.0.0-x-y-z.--", "semver", false},
+		{"build metadata", "1.0.0+20130313144700", "semver", false},
+		{"pre-release and build metadata", "1.0.0-beta+exp.sha.5114f85", "semver", false},
+		{"v prefix", "v1.2.3", "semver", true},
+		{"missing patch", "1.2", "semver", true},
+		{"leading zero", "01.2.3", "semver", true},
+		{"pre-release leading zero", "1.2.3-01", "semver", true},
+		{"empty pre-release identifier", "1.2.3-alpha..1", "semver", true},
+		{"empty build metadata", "1.2.3+", "semver", true},
+		{"invalid character", "1.2.3-beta_1", "semver", true},
+
+		{"caret accepts minor update", "1.9.0", "semver=^1.0.0", false},
+		{"caret rejects major update", "2.0.0", "semver=^1.0.0", true},
+		{"caret rejects lower version", "1.1.9", "semver=^1.2.0", true},
+		{"caret on zero major", "0.2.9", "semver=^0.2.3", false},
+		{"caret on zero major rejects minor update", "0.3.0", "semver=^0.2.3", true},
+		{"caret on zero minor", "0.0.4", "semver=^0.0.3", true},
+		{"tilde accepts patch update", "1.2.9", "semver=~1.2.3", false},
+		{"tilde rejects minor update", "1.3.0", "semver=~1.2.3", true},
+		{"bounds", "2.5.0", "semver=>=1.2.0 <3.0.0", false},
+		{"bounds with spaces", "3.0.0", "semver=>= 1.2.0 < 3.0.0", true},
+		{"wildcard", "1.2.7", "semver=1.2.x", false},
+		{"wildcard rejects other minor", "1.3.0", "semver=1.2.x", true},
+		{"partial greater than", "1.3.0", "semver=>1.2", false},
+		{"partial greater than rejects patch", "1.2.9", "semver=>1.2", true},
+		{"partial less than or equal", "1.2.9", "semver=<=1.2", false},
+		{"exact", "1.2.3", "semver=1.2.3", false},
+		{"not equal", "1.2.3", "semver=!=1.2.3", true},
+		{"alternatives", "2.1.0", "semver=^1.0.0 0x7C0x7C ^2.0.0", false},
+		{"alternatives reject", "3.0.0", "semver=^1.0.0 0x7C0x7C ^2.0.0", true},
+		{"pre-release excluded from range", "1.5.0-beta", "semver=^1.0.0", true},
+		{"pre-release of named version", "1.2.3-beta.2", "semver=>=1.2.3-beta.1 <2.0.0", false},
+		{"pre-release of other version", "1.2.4-beta.2", "semver=>=1.2.3-beta.1 <2.0.0", true},
+		{"build metadata ignored", "1.2.3+build.7", "semver=1.2.3", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := v.Var(tt.value, tt.tag)
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+
+	t.Run("precedence", func(t *testing.T) {
+		// Ordered by precedence as in the SemVer 2.0.0 specification
+		versions := []string{
+			"1.0.0-alpha", "1.0.0-alpha.1", "1.0.0-alpha.beta", "1.0.0-beta", "1.0.0-beta.2",
+			"1.0.0-beta.11", "1.0.0-rc.1", "1.0.0", "2.0.0", "2.1.0", "2.1.1",
+		}
+		for i := 1; i < len(versions); i++ {
+			lower, ok := parseSemver(versions[i-1])
+			require.True(t, ok)
+			higher, ok := parseSemver(versions[i])
+			require.True(t, ok)
+			assert.Equal(t, -1, lower.compare(higher), "%s < %s", versions[i-1], versions[i])
+			assert.Equal(t, 1, higher.compare(lower), "%s > %s", versions[i], versions[i-1])
+		}
+	})
+
+	t.Run("invalid range is a config error", func(t *testing.T) {
+		err := v.Var("1.2.3", "semver=^1.x.3")
+		var configErr *ConfigError
+		require.ErrorAs(t, err, &configErr)
+		assert.Equal(t, "semver", configErr.Tag)
+	})
+}
+
+func TestValidateSortableIDs(t *testing.T) {
+	v, err := NewValidator(WithClock(func() time.Time { return dateTestNow }))
+	require.NoError(t, err)
+
+	futureSnowflake := strconv.FormatInt((time.Date(2030, time.January, 1, 0, 0, 0, 0, time.UTC).UnixMilli()-snowflakeEpochs["twitter"])<<22, 10)
+
+	tests := []struct {
+		name    string
+		value   any
+		tag     string
+		wantErr bool
+	}{
+		{"ulid", "01ARZ3NDEKTSV4RRFFQ69G5FAV", "ulid", false},
+		{"ulid in lowercase", "01arz3ndektsv4rrffq69g5fav", "ulid", false},
+		{"ulid with time check", "01ARZ3NDEKTSV4RRFFQ69G5FAV", "ulid=time", false},
+		{"ulid from the future", "7ZZZZZZZZZ0000000000000000", "ulid", false},
+		{"ulid from the future with time check", "7ZZZZZZZZZ0000000000000000", "ulid=time", true},
+		{"ulid timestamp overflow", "8ZZZZZZZZZ0000000000000000", "ulid", true},
+		{"ulid too short", "01ARZ3NDEKTSV4RRFFQ69G5FA", "ulid", true},
+		{"ulid with excluded letter", "01ARZ3NDEKTSV4RRFFQ69G5FAU", "ulid", true},
+		{"uuid is not a ulid", "550e8400-e29b-41d4-a716-446655440000", "ulid", true},
+
+		{"ksuid", "0ujtsYcgvSTl8PAuAdqWYSMnLOv", "ksuid", false},
+		{"ksuid with time check", "0ujtsYcgvSTl8PAuAdqWYSMnLOv", "ksuid=time", false},
+		{"largest ksuid", maxKSUID, "ksuid", false},
+		{"largest ksuid with time check", maxKSUID, "ksuid=time", true},
+		{"ksuid overflow", "aWgEPTl1tmebfsQzFP4bxwgy80W", "ksuid", true},
+		{"ksuid too long", "0ujtsYcgvSTl8PAuAdqWYSMnLOvx", "ksuid", true},
+		{"ksuid with invalid character", "0ujtsYcgvSTl8PAuAdqWYSMnLO-", "ksuid", true},
+
+		{"twitter snowflake", "1541815603606036480", "snowflake", false},
+		{"twitter snowflake with time check", "1541815603606036480", "snowflake=time", false},
+		{"snowflake integer field", int64(1541815603606036480), "snowflake=time", false},
+		{"discord snowflake", "175928847299117063", "snowflake=discord time", false},
+		{"custom epoch", "175928847299117063", "snowflake=1420070400000 time", false},
+		{"snowflake from the future", futureSnowflake, "snowflake", false},
+		{"snowflake from the future with time check", futureSnowflake, "snowflake=time", true},
+		{"zero snowflake", "0", "snowflake", true},
+		{"negative snowflake", int64(-5), "snowflake", true},
+		{"snowflake with leading zero", "01541815603606036480", "snowflake", true},
+		{"snowflake overflow", "9223372036854775808", "snowflake", true},
+		{"snowflake with letters", "15418156036060364a0", "snowflake", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := v.Var(tt.value, tt.tag)
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+
+	t.Run("embedded timestamps", func(t *testing.T) {
+		ulid, ok := ulidTime("01ARZ3NDEKTSV4RRFFQ69G5FAV")
+		require.True(t, ok)
+		assert.Equal(t, time.UnixMilli(1469922850259), ulid)
+
+		ksuid, ok := ksuidTime("0ujtsYcgvSTl8PAuAdqWYSMnLOv")
+		require.True(t, ok)
+		assert.Equal(t, time.Unix(1507608047, 0), ksuid)
+	})
+
+	t.Run("invalid parameters are config errors", func(t *testing.T) {
+		for _, tag := range []string{"ulid=recent", "ksuid=1h", "snowflake=mastodon"} {
+			err := v.Var("1", tag)
+			var configErr *ConfigError
+			require.ErrorAs(t, err, &configErr, tag)
+		}
+	})
+}
+
+func TestValidateNanoID(t *testing.T) {
+	v, err := NewValidator()
+	require.NoError(t, err)
+
+	tests := []struct {
+		name    string
+		value   string
+		tag     string
+		wantErr bool
+	}{
+		{"default length", "V1StGXR8_Z5jdHi6B-myT", "nanoid", false},
+		{"explicit length", "V1StGXR8_Z5jdHi6B-myT", "nanoid=21", false},
+		{"short id", "IRFa-VaY2b", "nanoid=10", false},
+		{"custom alphabet", "4f90d13a42bc", "nanoid=12:0123456789abcdef", false},
+		{"too short", "V1StGXR8_Z5jdHi6B-my", "nanoid", true},
+		{"too long", "V1StGXR8_Z5jdHi6B-myTx", "nanoid=21", true},
+		{"character outside alphabet", "V1StGXR8_Z5jdHi6B+myT", "nanoid", true},
+		{"character outside custom alphabet", "4F90d13a42bc", "nanoid=12:0123456789abcdef", true},
+		{"non-ASCII character", "V1StGXR8_Z5jdHi6B-myก", "nanoid", true},
+		{"empty", "", "nanoid", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := v.Var(tt.value, tt.tag)
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+
+	t.Run("invalid length is a config error", func(t *testing.T) {
+		err := v.Var("V1StGXR8_Z5jdHi6B-myT", "nanoid=short")
+		var configErr *ConfigError
+		require.ErrorAs(t, err, &configErr)
+		assert.Equal(t, "nanoid", configErr.Tag)
+	})
+}
@@ -0,0 +1,102 @@
+package xvalidator
+
+import (
+	"reflect"
+	"strings"
+
+	"github.com/go-playground/validator/v10"
+	"golang.org/x/text/language"
+)
+
+// Locale validation logic functions
+
+// validateCountryCode validates ISO 3166-1 country codes.
+// Codes are matched case-sensitively against the embedded table (e.g., "TH", "THA").
+// Usage:
+//   - `validate:"country_code"` - alpha-2 code (default)
+//   - `validate:"country_code=alpha2"` - alpha-2 code
+//   - `validate:"country_code=alpha3"` - alpha-3 code
+func validateCountryCode(fl validator.FieldLevel) bool {
+	field := fl.Field()
+
+	var lookup func(string) (string, bool)
+	switch fl.Param() {
+	case "", "alpha2":
+		lookup = CountryAlpha3
+	case "alpha3":
+		lookup = CountryAlpha2
+	default:
+		panicConfigError(fl, "expected no parameter, 'alpha2' or 'alpha3'")
+	}
+
+	if field.Kind() != reflect.String {
+		return false
+	}
+
+	_, ok := lookup(field.String())
+	return ok
+}
+
+// validateBCP47 validates BCP 47 language tags such as "th-TH", "en-US" or "zh-Hant-TW" by parsing them with
+// golang.org/x/text/language. Subtags must be separated by hyphens and be registered in the IANA registry.
+// Unlike go-playground/validator's bcp47_language_tag, underscores are rejected and non-string fields are
+// invalid instead of panicking. The "canonical" parameter also requires the canonical form, with standard
+// casing and preferred subtags (e.g., "he" rather than "iw"), so stored preferences compare equal.
+// Usage:
+//   - `validate:"bcp47"` - e.g., "th-TH", "en-us" or "th-TH-u-nu-thai"
+//   - `validate:"bcp47=canonical"` - e.g., "en-US" but not "en-us"
+func validateBCP47(fl validator.FieldLevel) bool {
+	var canonical bool
+	switch fl.Param() {
+	case "":
+	case "canonical":
+		canonical = true
+	default:
+		panicConfigError(fl, "expected no parameter or canonical")
+	}
+
+	field := fl.Field()
+	if field.Kind() != reflect.String {
+		return false
+	}
+
+	value := field.String()
+	if strings.ContainsRune(value, '_') {
+		return false
+	}
+	tag, err := language.Parse(value)
+	return err == nil && (!canonical || tag.String() == value)
+}
+
+// validateLocale validates locale identifiers such as "th_TH" or "en-US" against the embedded list of known
+// locales (see KnownLocales). The language must be lowercase and the territory uppercase; use CanonicalLocale
+// or the locale_canonical modifier to normalize user input first. The "posix" parameter requires an underscore
+// separator and the "bcp47" parameter a hyphen.
+// Usage:
+//   - `validate:"locale"` - e.g., "th_TH" or "th-TH"
+//   - `validate:"locale=posix"` - e.g., "th_TH"
+//   - `validate:"locale=bcp47"` - e.g., "th-TH"
+func validateLocale(fl validator.FieldLevel) bool {
+	var required byte
+	switch fl.Param() {
+	case "":
+	case "posix":
+		required = '_'
+	case "bcp47":
+		required = '-'
+	default:
+		panicConfigError(fl, "expected no parameter, posix or bcp47")
+	}
+
+	field := fl.Field()
+	if field.Kind() != reflect.String {
+		return false
+	}
+
+	language, territory, separator, ok := splitLocale(field.String())
+	if !ok || (required != 0 && separator != required) {
+		return false
+	}
+	_, known := embeddedLocales()[language+"_"+territory]
+	return known
+}
@@ -0,0 +1,220 @@
+package xvalidator
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateCountryCode(t *testing.T) {
+	v := validator.New()
+	RegisterLocaleValidators(v)
+
+	tests := []struct {
+		name    string
+		value   interface{}
+		tag     string
+		wantErr bool
+	}{
+		{name: "valid alpha-2 TH", value: "TH", tag: "country_code", wantErr: false},
+		{name: "valid alpha-2 US", value: "US", tag: "country_code", wantErr: false},
+		{name: "valid alpha-2 explicit", value: "GB", tag: "country_code=alpha2", wantErr: false},
+		{name: "valid alpha-2 territory", value: "AX", tag: "country_code", wantErr: false},
+		{name: "lowercase alpha-2", value: "th", tag: "country_code", wantErr: true},
+		{name: "reserved alpha-2 UK", value: "UK", tag: "country_code", wantErr: true},
+		{name: "user-assigned alpha-2 XX", value: "XX", tag: "country_code", wantErr: true},
+		{name: "alpha-3 under alpha-2 rule", value: "THA", tag: "country_code", wantErr: true},
+		{name: "empty string", value: "", tag: "country_code", wantErr: true},
+		{name: "valid alpha-3 THA", value: "THA", tag: "country_code=alpha3", wantErr: false},
+		{name: "valid alpha-3 USA", value: "USA", tag: "country_code=alpha3", wantErr: false},
+		{name: "alpha-2 under alpha-3 rule", value: "TH", tag: "country_code=alpha3", wantErr: true},
+		{name: "unknown alpha-3", value: "ABC", tag: "country_code=alpha3", wantErr: true},
+		{name: "non-string value", value: 764, tag: "country_code", wantErr: true},
+		{name: "omitempty with empty string", value: "", tag: "omitempty,country_code", wantErr: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := v.Var(tt.value, tt.tag)
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestValidateCountryCode_InvalidParam(t *testing.T) {
+	v, err := NewValidator()
+	require.NoError(t, err)
+
+	err = v.Var("TH", "country_code=numeric")
+
+	var configErr *ConfigError
+	require.ErrorAs(t, err, &configErr)
+	assert.Equal(t, "country_code", configErr.Tag)
+	assert.Equal(t, "numeric", configErr.Param)
+}
+
+func TestCountryCodeTables(t *testing.T) {
+	// ISO 3166-1 currently assigns 249 country codes
+	assert.Len(t, countryAlpha3, 249)
+	assert.Len(t, countryAlpha2, len(countryAlpha3), "alpha-3 codes must be unique")
+
+	alpha3, ok := CountryAlpha3("TH")
+	assert.True(t, ok)
+	assert.Equal(t, "THA", alpha3)
+
+	alpha2, ok := CountryAlpha2("DEU")
+	assert.True(t, ok)
+	assert.Equal(t, "DE", alpha2)
+
+	_, ok = CountryAlpha3("ZZ")
+	assert.False(t, ok)
+}
+
+func TestValidateBCP47(t *testing.T) {
+	v, err := NewValidator()
+	require.NoError(t, err)
+
+	tests := []struct {
+		name    string
+		value   interface{}
+		tag     string
+		wantErr bool
+	}{
+		{name: "language and region", value: "th-TH", tag: "bcp47", wantErr: false},
+		{name: "language only", value: "en", tag: "bcp47", wantErr: false},
+		{name: "script", value: "zh-Hant-TW", tag: "bcp47", wantErr: false},
+		{name: "unicode extension", value: "th-TH-u-nu-thai", tag: "bcp47", wantErr: false},
+		{name: "private use", value: "en-US-x-twain", tag: "bcp47", wantErr: false},
+		{name: "mixed case", value: "EN-us", tag: "bcp47", wantErr: false},
+		{name: "deprecated subtag", value: "iw", tag: "bcp47", wantErr: false},
+		{name: "canonical", value: "en-US", tag: "bcp47=canonical", wantErr: false},
+		{name: "canonical with script", value: "zh-Hant-TW", tag: "bcp47=canonical", wantErr: false},
+		{name: "underscore separator", value: "th_TH", tag: "bcp47", wantErr: true},
+		{name: "unknown language", value: "xx-ZZ", tag: "bcp47", wantErr: true},
+		{name: "trailing hyphen", value: "en-", tag: "bcp47", wantErr: true},
+		{name: "whitespace", value: " en-US", tag: "bcp47", wantErr: true},
+		{name: "language name", value: "English", tag: "bcp47", wantErr: true},
+		{name: "empty string", value: "", tag: "bcp47", wantErr: true},
+		{name: "non-canonical case", value: "en-us", tag: "bcp47=canonical", wantErr: true},
+		{name: "non-canonical subtag", value: "iw", tag: "bcp47=canonical", wantErr: true},
+		{name: "non-string value", value: 66, tag: "bcp47", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := v.Var(tt.value, tt.tag)
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+
+	t.Run("invalid param is a config error", func(t *testing.T) {
+		err := v.Var("th-TH", "bcp47=strict")
+
+		var configErr *ConfigError
+		require.ErrorAs(t, err, &configErr)
+		assert.Equal(t, "bcp47", configErr.Tag)
+	})
+}
+
+func TestValidateLocale(t *testing.T) {
+	v, err := NewValidator()
+	require.NoError(t, err)
+
+	tests := []struct {
+		name    string
+		value   interface{}
+		tag     string
+		wantErr bool
+	}{
+		{name: "underscore", value: "th_TH", tag: "locale", wantErr: false},
+		{name: "hyphen", value: "en-US", tag: "locale", wantErr: false},
+		{name: "three-letter language", value: "fil_PH", tag: "locale", wantErr: false},
+		{name: "posix", value: "pt_BR", tag: "locale=posix", wantErr: false},
+		{name: "bcp47", value: "zh-TW", tag: "locale=bcp47", wantErr: false},
+		{name: "unknown pair", value: "th_US", tag: "locale", wantErr: true},
+		{name: "unknown language", value: "xx_TH", tag: "locale", wantErr: true},
+		{name: "language only", value: "th", tag: "locale", wantErr: true},
+		{name: "uppercase language", value: "TH_TH", tag: "locale", wantErr: true},
+		{name: "lowercase territory", value: "th_th", tag: "locale", wantErr: true},
+		{name: "encoding suffix", value: "th_TH.UTF-8", tag: "locale", wantErr: true},
+		{name: "script", value: "zh-Hant-TW", tag: "locale", wantErr: true},
+		{name: "hyphen under posix", value: "th-TH", tag: "locale=posix", wantErr: true},
+		{name: "underscore under bcp47", value: "th_TH", tag: "locale=bcp47", wantErr: true},
+		{name: "empty string", value: "", tag: "locale", wantErr: true},
+		{name: "non-string value", value: 1054, tag: "locale", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := v.Var(tt.value, tt.tag)
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+
+	t.Run("invalid param is a config error", func(t *testing.T) {
+		err := v.Var("th_TH", "locale=icu")
+
+		var configErr *ConfigError
+		require.ErrorAs(t, err, &configErr)
+		assert.Equal(t, "locale", configErr.Tag)
+	})
+}
+
+func TestCanonicalLocale(t *testing.T) {
+	tests := map[string]string{
+		"th_TH":   "th_TH",
+		"th-TH":   "th_TH",
+		"TH-th":   "th_TH",
+		" en-us ": "en_US",
+		"FIL_ph":  "fil_PH",
+		"th_US":   "th_US",
+		"english": "english",
+		"":        "",
+	}
+	for input, expected := range tests {
+		assert.Equal(t, expected, CanonicalLocale(input), input)
+	}
+
+	type Settings struct {
+		Locale string `mod:"locale_canonical" validate:"required,locale=posix"`
+	}
+	v, err := NewValidator()
+	require.NoError(t, err)
+
+	settings := Settings{Locale: "EN-gb"}
+	require.NoError(t, v.Modify(&settings))
+	assert.Equal(t, "en_GB", settings.Locale)
+	assert.NoError(t, v.Struct(settings))
+}
+
+func TestKnownLocales(t *testing.T) {
+	locales := KnownLocales()
+	assert.Contains(t, locales, "th_TH")
+
+	seen := make(map[string]bool, len(locales))
+	for _, locale := range locales {
+		language, territory, separator, ok := splitLocale(locale)
+		require.True(t, ok, locale)
+		assert.Equal(t, byte('_'), separator, locale)
+		assert.Equal(t, strings.ToLower(language), language, locale)
+		_, isCountry := CountryAlpha3(territory)
+		assert.True(t, isCountry, locale)
+		assert.False(t, seen[locale], "duplicate %s", locale)
+		seen[locale] = true
+	}
+}
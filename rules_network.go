@@ -0,0 +1,230 @@
+package xvalidator
+
+import (
+	"net/netip"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/go-playground/validator/v10"
+)
+
+// IP allowlist validation logic functions
+
+// ipPrefixesCache caches parseIPPrefixes results keyed by the raw tag parameter.
+var ipPrefixesCache sync.Map
+
+// parseIPPrefixes parses a space-separated list of CIDR prefixes ("10.0.0.0/8 2001:db8::/32") or single
+// addresses, which match only themselves. Host bits set in a prefix are ignored.
+func parseIPPrefixes(param string) ([]netip.Prefix, bool) {
+	if cached, ok := ipPrefixesCache.Load(param); ok {
+		return cached.([]netip.Prefix), true
+	}
+
+	fields := strings.Fields(param)
+	if len(fields) == 0 {
+		return nil, false
+	}
+
+	prefixes := make([]netip.Prefix, 0, len(fields))
+	for _, field := range fields {
+		var prefix netip.Prefix
+		if strings.Contains(field, "/") {
+			parsed, err := netip.ParsePrefix(field)
+			if err != nil {
+				return nil, false
+			}
+			prefix = parsed.Masked()
+		} else {
+			addr, err := netip.ParseAddr(field)
+			if err != nil || addr.Zone() != "" {
+				return nil, false
+			}
+			prefix = netip.PrefixFrom(addr.Unmap(), addr.Unmap().BitLen())
+		}
+		prefixes = append(prefixes, prefix)
+	}
+
+	ipPrefixesCache.Store(param, prefixes)
+	return prefixes, true
+}
+
+// validateIPIn validates that an IP address string lies within one of the CIDR prefixes of the parameter.
+// IPv4-mapped IPv6 addresses ("::ffff:10.0.0.1") are matched as IPv4; addresses with a zone are invalid.
+// Usage:
+//   - `validate:"ip_in=10.0.0.0/8 192.168.0.0/16"` - private office ranges
+//   - `validate:"ip_in=203.0.113.10 2001:db8::/32"` - a single address and an IPv6 network
+func validateIPIn(fl validator.FieldLevel) bool {
+	prefixes, ok := parseIPPrefixes(fl.Param())
+	if !ok {
+		panicConfigError(fl, "expected space-separated CIDR prefixes such as 10.0.0.0/8")
+	}
+
+	field := fl.Field()
+	if field.Kind() != reflect.String {
+		return false
+	}
+
+	addr, err := netip.ParseAddr(field.String())
+	if err != nil || addr.Zone() != "" {
+		return false
+	}
+	addr = addr.Unmap()
+	for _, prefix := range prefixes {
+		if prefix.Contains(addr) {
+			return true
+		}
+	}
+	return false
+}
+
+// Port validation logic functions
+
+// maxPort is the highest TCP and UDP port number.
+const maxPort = 65535
+
+// parsePort parses a decimal port number between 1 and 65535, without a sign or leading zeros.
+func parsePort(s string) (int, bool) {
+	if s == "" || len(s) > 5 || s[0] == '0' || !isASCIIDigits(s) {
+		return 0, false
+	}
+	port, _ := strconv.Atoi(s)
+	return port, port <= maxPort
+}
+
+// parsePortRange parses an inclusive "min:max" port range, such as "1024:65535".
+func parsePortRange(param string) (minPort, maxPort int, ok bool) {
+	minText, maxText, found := strings.Cut(param, ":")
+	if !found {
+		return 0, 0, false
+	}
+	minPort, minOK := parsePort(minText)
+	maxPort, maxOK := parsePort(maxText)
+	return minPort, maxPort, minOK && maxOK && minPort <= maxPort
+}
+
+// portFieldValue returns the port held by a string or integer field, or false when it is not from 1 to 65535.
+func portFieldValue(field reflect.Value) (int, bool) {
+	switch field.Kind() {
+	case reflect.String:
+		return parsePort(field.String())
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		port := field.Int()
+		return int(port), port >= 1 && port <= maxPort
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		port := field.Uint()
+		return int(port), port >= 1 && port <= maxPort
+	default:
+		return 0, false
+	}
+}
+
+// validatePort validates a TCP or UDP port number from 1 to 65535 held by an integer field or a decimal string
+// without a sign or leading zeros. It replaces go-playground/validator's port rule, which accepts unsigned
+// integer fields only.
+// Usage:
+//   - `validate:"port"` - e.g., 8080 or "8080"
+func validatePort(fl validator.FieldLevel) bool {
+	_, ok := portFieldValue(fl.Field())
+	return ok
+}
+
+// validatePortRange validates a port number, as accepted by the port rule, within an inclusive "min:max" range.
+// Usage:
+//   - `validate:"port_range=1024:65535"` - unprivileged ports
+//   - `validate:"port_range=30000:32767"` - Kubernetes NodePort range
+func validatePortRange(fl validator.FieldLevel) bool {
+	minPort, maxPort, ok := parsePortRange(fl.Param())
+	if !ok {
+		panicConfigError(fl, "expected a port range such as 1024:65535")
+	}
+
+	port, ok := portFieldValue(fl.Field())
+	return ok && port >= minPort && port <= maxPort
+}
+
+// Host and port validation logic functions
+
+// hostPortParams holds the parsed form of a hostport rule parameter.
+type hostPortParams struct {
+	requirePort      bool
+	minPort, maxPort int
+}
+
+// parseHostPortParam parses a hostport parameter: space-separated "required" and "min:max" options.
+func parseHostPortParam(param string) (hostPortParams, bool) {
+	params := hostPortParams{minPort: 1, maxPort: maxPort}
+	for _, option := range strings.Fields(param) {
+		if option == "required" {
+			params.requirePort = true
+			continue
+		}
+		minPort, maxPort, ok := parsePortRange(option)
+		if !ok {
+			return hostPortParams{}, false
+		}
+		params.minPort, params.maxPort = minPort, maxPort
+	}
+	return params, true
+}
+
+// splitHostPort splits "host", "host:port", "[ipv6]" or "[ipv6]:port" into its host and optional port.
+// Unlike net.SplitHostPort, the port may be omitted, and IPv6 addresses must be enclosed in brackets so a
+// trailing port cannot be mistaken for part of the address.
+func splitHostPort(s string) (host, port string, hasPort, ok bool) {
+	if strings.HasPrefix(s, "[") {
+		end := strings.IndexByte(s, ']')
+		if end < 0 {
+			return "", "", false, false
+		}
+		host, rest := s[1:end], s[end+1:]
+		addr, err := netip.ParseAddr(host)
+		if err != nil || !addr.Is6() || addr.Zone() != "" {
+			return "", "", false, false
+		}
+		if rest == "" {
+			return host, "", false, true
+		}
+		port, hasPort = strings.CutPrefix(rest, ":")
+		return host, port, hasPort, hasPort
+	}
+
+	host, port, hasPort = strings.Cut(s, ":")
+	if strings.Contains(port, ":") {
+		return "", "", false, false
+	}
+	if addr, err := netip.ParseAddr(host); err == nil {
+		return host, port, hasPort, addr.Is4()
+	}
+	return host, port, hasPort, isHostnameLabels(strings.ToLower(host)) && !isNumericHost(host)
+}
+
+// validateHostPort validates a "host:port" pair for service endpoints. The host is an RFC 1123 host name,
+// an IPv4 address or a bracketed IPv6 address; the port is a number from 1 to 65535 and may be omitted
+// unless the "required" option is given. A "min:max" option restricts the port range.
+// Usage:
+//   - `validate:"hostport"` - e.g., "db.internal:5432", "10.0.0.5" or "[2001:db8::1]:443"
+//   - `validate:"hostport=required"` - the port must be given
+//   - `validate:"hostport=required 1024:65535"` - an unprivileged port must be given
+func validateHostPort(fl validator.FieldLevel) bool {
+	params, ok := parseHostPortParam(fl.Param())
+	if !ok {
+		panicConfigError(fl, "expected required and/or a port range such as 1024:65535")
+	}
+
+	field := fl.Field()
+	if field.Kind() != reflect.String {
+		return false
+	}
+
+	_, portText, hasPort, ok := splitHostPort(field.String())
+	if !ok {
+		return false
+	}
+	if !hasPort {
+		return !params.requirePort
+	}
+	port, ok := parsePort(portText)
+	return ok && port >= params.minPort && port <= params.maxPort
+}
@@ -0,0 +1,182 @@
+package xvalidator
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateIPIn(t *testing.T) {
+	v, err := NewValidator()
+	require.NoError(t, err)
+
+	const private = "ip_in=10.0.0.0/8 192.168.0.0/16"
+
+	tests := []struct {
+		name    string
+		value   any
+		tag     string
+		wantErr bool
+	}{
+		{"first range", "10.1.2.3", private, false},
+		{"second range", "192.168.100.1", private, false},
+		{"network address", "10.0.0.0", private, false},
+		{"ipv4-mapped ipv6", "::ffff:10.0.0.1", private, false},
+		{"ipv6 network", "2001:db8::1", "ip_in=2001:db8::/32", false},
+		{"single address", "203.0.113.10", "ip_in=203.0.113.10", false},
+		{"host bits in prefix", "10.9.9.9", "ip_in=10.1.2.3/8", false},
+		{"outside", "8.8.8.8", private, true},
+		{"adjacent range", "192.169.0.1", private, true},
+		{"ipv6 outside", "2001:db9::1", "ip_in=2001:db8::/32", true},
+		{"ipv4 in ipv6 network", "10.0.0.1", "ip_in=::/0", true},
+		{"other single address", "203.0.113.11", "ip_in=203.0.113.10", true},
+		{"cidr value", "10.0.0.0/8", private, true},
+		{"zone", "fe80::1%eth0", "ip_in=fe80::/10", true},
+		{"hostname", "localhost", private, true},
+		{"leading zeros", "010.0.0.1", private, true},
+		{"empty", "", private, true},
+		{"non-string", 167772161, private, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := v.Var(tt.value, tt.tag)
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+
+	for _, param := range []string{"", "10.0.0.0/33", "office"} {
+		t.Run("invalid prefixes "+param+" are a config error", func(t *testing.T) {
+			err := v.Var("10.0.0.1", "ip_in="+param)
+
+			var configErr *ConfigError
+			require.ErrorAs(t, err, &configErr)
+			assert.Equal(t, "ip_in", configErr.Tag)
+		})
+	}
+}
+
+func TestValidateHostPort(t *testing.T) {
+	v, err := NewValidator()
+	require.NoError(t, err)
+
+	tests := []struct {
+		name    string
+		value   any
+		tag     string
+		wantErr bool
+	}{
+		{"hostname and port", "db.example.com:5432", "hostport", false},
+		{"single-label hostname", "redis:6379", "hostport", false},
+		{"uppercase hostname", "API.Example.com:443", "hostport", false},
+		{"hostname without port", "db.example.com", "hostport", false},
+		{"ipv4 and port", "10.0.0.5:8080", "hostport", false},
+		{"ipv4 without port", "10.0.0.5", "hostport", false},
+		{"ipv6 and port", "[2001:db8::1]:443", "hostport", false},
+		{"ipv6 without port", "[::1]", "hostport", false},
+		{"highest port", "localhost:65535", "hostport", false},
+		{"required port", "db.example.com:5432", "hostport=required", false},
+		{"port in range", "db.example.com:8080", "hostport=1024:65535", false},
+		{"required port in range", "db.example.com:8080", "hostport=required 1024:65535", false},
+		{"range without port", "db.example.com", "hostport=1024:65535", false},
+		{"port zero", "db.example.com:0", "hostport", true},
+		{"port too high", "db.example.com:65536", "hostport", true},
+		{"port with leading zero", "db.example.com:0443", "hostport", true},
+		{"named port", "db.example.com:https", "hostport", true},
+		{"empty port", "db.example.com:", "hostport", true},
+		{"empty host", ":8080", "hostport", true},
+		{"unbracketed ipv6", "2001:db8::1", "hostport", true},
+		{"unbracketed ipv6 and port", "::1:8080", "hostport", true},
+		{"bracketed ipv4", "[10.0.0.5]:80", "hostport", true},
+		{"ipv6 zone", "[fe80::1%eth0]:80", "hostport", true},
+		{"unterminated bracket", "[::1:80", "hostport", true},
+		{"invalid ipv4", "10.0.0.256:80", "hostport", true},
+		{"underscore", "my_service:80", "hostport", true},
+		{"leading hyphen", "-db.example.com:80", "hostport", true},
+		{"url", "https://db.example.com:443", "hostport", true},
+		{"path", "db.example.com:443/path", "hostport", true},
+		{"missing required port", "db.example.com", "hostport=required", true},
+		{"port below range", "db.example.com:80", "hostport=1024:65535", true},
+		{"empty", "", "hostport", true},
+		{"non-string", 8080, "hostport", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := v.Var(tt.value, tt.tag)
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+
+	for _, param := range []string{"optional", "1024", "2000:1000", "1:70000"} {
+		t.Run("invalid option "+param+" is a config error", func(t *testing.T) {
+			err := v.Var("db.example.com:5432", "hostport="+param)
+
+			var configErr *ConfigError
+			require.ErrorAs(t, err, &configErr)
+			assert.Equal(t, "hostport", configErr.Tag)
+		})
+	}
+}
+
+func TestValidatePort(t *testing.T) {
+	v, err := NewValidator()
+	require.NoError(t, err)
+
+	tests := []struct {
+		name    string
+		value   any
+		tag     string
+		wantErr bool
+	}{
+		{"int", 8080, "port", false},
+		{"uint16", uint16(443), "port", false},
+		{"string", "5432", "port", false},
+		{"lowest", 1, "port", false},
+		{"highest", "65535", "port", false},
+		{"in range", 8080, "port_range=1024:65535", false},
+		{"range bound", "30000", "port_range=30000:32767", false},
+		{"single port range", 443, "port_range=443:443", false},
+		{"zero", 0, "port", true},
+		{"negative", -80, "port", true},
+		{"too high", 65536, "port", true},
+		{"too high string", "65536", "port", true},
+		{"leading zero", "080", "port", true},
+		{"sign", "+80", "port", true},
+		{"whitespace", " 80", "port", true},
+		{"service name", "http", "port", true},
+		{"float", 80.0, "port", true},
+		{"below range", 80, "port_range=1024:65535", true},
+		{"above range", "32768", "port_range=30000:32767", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := v.Var(tt.value, tt.tag)
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+
+	for _, param := range []string{"", "1024", "2000:1000", "0:1024", "1024-65535"} {
+		t.Run("invalid range "+param+" is a config error", func(t *testing.T) {
+			err := v.Var(8080, "port_range="+param)
+
+			var configErr *ConfigError
+			require.ErrorAs(t, err, &configErr)
+			assert.Equal(t, "port_range", configErr.Tag)
+		})
+	}
+}
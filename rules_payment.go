@@ -0,0 +1,390 @@
+package xvalidator
+
+import (
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-playground/validator/v10"
+)
+
+// Payment validation logic functions
+
+// validateISO4217 validates ISO 4217 alphabetic currency codes.
+// Codes are matched case-sensitively against the embedded table (e.g., "THB", "USD", "EUR").
+// Fund codes such as "CLF" or "USN" are rejected unless the "funds" parameter is given.
+// Usage:
+//   - `validate:"iso4217"` - circulating currencies only
+//   - `validate:"iso4217=funds"` - also accept fund codes
+func validateISO4217(fl validator.FieldLevel) bool {
+	var allowFunds bool
+	switch fl.Param() {
+	case "":
+	case "funds":
+		allowFunds = true
+	default:
+		panicConfigError(fl, "expected no parameter or 'funds'")
+	}
+
+	field := fl.Field()
+	if field.Kind() != reflect.String {
+		return false
+	}
+
+	return isCurrencyCode(field.String(), allowFunds)
+}
+
+// cardBrand describes the issuer identification number (IIN) ranges and lengths of a card brand.
+type cardBrand struct {
+	name        string
+	displayName string
+	prefixes    [][2]string // inclusive ranges of equal-length digit prefixes
+	lengths     []int
+}
+
+// cardBrands lists the supported card brands in detection order.
+// Discover is checked before UnionPay because the co-branded 622126-622925 range belongs to Discover.
+var cardBrands = []cardBrand{
+	{name: "visa", displayName: "Visa", prefixes: [][2]string{{"4", "4"}}, lengths: []int{13, 16, 19}},
+	{name: "mastercard", displayName: "Mastercard", prefixes: [][2]string{{"51", "55"}, {"2221", "2720"}}, lengths: []int{16}},
+	{name: "amex", displayName: "American Express", prefixes: [][2]string{{"34", "34"}, {"37", "37"}}, lengths: []int{15}},
+	{name: "discover", displayName: "Discover", prefixes: [][2]string{{"6011", "6011"}, {"644", "649"}, {"65", "65"}, {"622126", "622925"}}, lengths: []int{16, 17, 18, 19}},
+	{name: "jcb", displayName: "JCB", prefixes: [][2]string{{"3528", "3589"}}, lengths: []int{16, 17, 18, 19}},
+	{name: "diners", displayName: "Diners Club", prefixes: [][2]string{{"300", "305"}, {"36", "36"}, {"38", "39"}}, lengths: []int{14, 15, 16, 17, 18, 19}},
+	{name: "unionpay", displayName: "UnionPay", prefixes: [][2]string{{"62", "62"}}, lengths: []int{16, 17, 18, 19}},
+}
+
+// matches reports whether a digit string falls within the brand's IIN ranges and allowed lengths.
+func (b cardBrand) matches(number string) bool {
+	lengthOK := false
+	for _, length := range b.lengths {
+		if len(number) == length {
+			lengthOK = true
+			break
+		}
+	}
+	if !lengthOK {
+		return false
+	}
+
+	for _, r := range b.prefixes {
+		if len(number) < len(r[0]) {
+			continue
+		}
+		prefix := number[:len(r[0])]
+		if prefix >= r[0] && prefix <= r[1] {
+			return true
+		}
+	}
+	return false
+}
+
+// lookupCardBrand returns the card brand registered under name.
+func lookupCardBrand(name string) (cardBrand, bool) {
+	for _, brand := range cardBrands {
+		if brand.name == name {
+			return brand, true
+		}
+	}
+	return cardBrand{}, false
+}
+
+// luhnValid reports whether a digit string passes the Luhn (mod 10) checksum.
+func luhnValid(number string) bool {
+	sum := 0
+	double := false
+	for i := len(number) - 1; i >= 0; i-- {
+		digit := int(number[i] - '0')
+		if double {
+			digit *= 2
+			if digit > 9 {
+				digit -= 9
+			}
+		}
+		sum += digit
+		double = !double
+	}
+	return sum%10 == 0
+}
+
+// isCardDigits reports whether s consists of 12 to 19 ASCII digits, the ISO/IEC 7812 card number length range.
+func isCardDigits(s string) bool {
+	if len(s) < 12 || len(s) > 19 {
+		return false
+	}
+	for i := 0; i < len(s); i++ {
+		if s[i] < '0' || s[i] > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+// CardBrand returns the brand of a card number (e.g., "visa", "mastercard"), detected from its IIN prefix and length.
+// The number must contain digits only. Returns false when the number is malformed or matches no supported brand.
+// The Luhn checksum is not verified.
+func CardBrand(number string) (string, bool) {
+	if !isCardDigits(number) {
+		return "", false
+	}
+	for _, brand := range cardBrands {
+		if brand.matches(number) {
+			return brand.name, true
+		}
+	}
+	return "", false
+}
+
+// validateCardNumber validates payment card numbers using the Luhn checksum.
+// The value must contain digits only; strip spaces and hyphens before validation.
+// An optional space-separated brand list restricts the accepted brands.
+// Supported brands: visa, mastercard, amex, discover, jcb, diners, unionpay.
+// Usage:
+//   - `validate:"card_number"` - any Luhn-valid card number
+//   - `validate:"card_number=visa mastercard"` - Visa or Mastercard only
+func validateCardNumber(fl validator.FieldLevel) bool {
+	brands := strings.Fields(fl.Param())
+	for _, name := range brands {
+		if _, ok := lookupCardBrand(name); !ok {
+			panicConfigError(fl, "unknown card brand '"+name+"'")
+		}
+	}
+
+	field := fl.Field()
+	if field.Kind() != reflect.String {
+		return false
+	}
+
+	number := field.String()
+	if !isCardDigits(number) || !luhnValid(number) {
+		return false
+	}
+
+	if len(brands) == 0 {
+		return true
+	}
+
+	for _, name := range brands {
+		brand, _ := lookupCardBrand(name)
+		if brand.matches(number) {
+			return true
+		}
+	}
+	return false
+}
+
+// parseCardExpiryParam parses a card_expiry parameter in "MonthField:YearField" format.
+// Example: "ExpiryMonth:ExpiryYear" -> monthField=ExpiryMonth, yearField=ExpiryYear
+func parseCardExpiryParam(param string) (monthField, yearField string, ok bool) {
+	monthField, yearField, found := strings.Cut(param, ":")
+	if !found || monthField == "" || yearField == "" {
+		return "", "", false
+	}
+	return monthField, yearField, true
+}
+
+// cardExpiryNumber reads a month or year component stored as a string of digits or as an integer.
+// Returns the number of digits for strings (0 for integers) so two-digit years can be expanded.
+func cardExpiryNumber(field reflect.Value) (value, digits int, ok bool) {
+	switch field.Kind() {
+	case reflect.String:
+		s := field.String()
+		if s == "" || len(s) > 4 {
+			return 0, 0, false
+		}
+		for i := 0; i < len(s); i++ {
+			if s[i] < '0' || s[i] > '9' {
+				return 0, 0, false
+			}
+		}
+		n, err := strconv.Atoi(s)
+		if err != nil {
+			return 0, 0, false
+		}
+		return n, len(s), true
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return int(field.Int()), 0, true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return int(field.Uint()), 0, true
+	default:
+		return 0, 0, false
+	}
+}
+
+// validateCardExpiry creates a cross-field validator asserting that a card expiry month/year pair
+// is a real month that has not passed according to now.
+// Months range from 1 to 12; years are two-digit (YY, interpreted as 20YY) or four-digit (YYYY).
+// A card remains valid until the end of its expiry month.
+// Usage: `validate:"card_expiry=ExpiryMonth:ExpiryYear"` on any field of the struct
+func validateCardExpiry(now func() time.Time) validator.Func {
+	return func(fl validator.FieldLevel) bool {
+		monthField, yearField, ok := parseCardExpiryParam(fl.Param())
+		if !ok {
+			panicConfigError(fl, "expected format MonthField:YearField")
+		}
+
+		monthValue, monthFound := lookupFieldPath(fl.Parent(), monthField)
+		yearValue, yearFound := lookupFieldPath(fl.Parent(), yearField)
+		if !monthFound || !yearFound {
+			panicConfigError(fl, "expiry references a field that does not exist")
+		}
+
+		month, _, ok := cardExpiryNumber(monthValue)
+		if !ok || month < 1 || month > 12 {
+			return false
+		}
+
+		year, digits, ok := cardExpiryNumber(yearValue)
+		if !ok {
+			return false
+		}
+		switch {
+		case digits == 2 || (digits == 0 && year < 100):
+			year += 2000
+		case digits == 4 || (digits == 0 && year >= 1000 && year <= 9999):
+		default:
+			return false
+		}
+
+		current := now()
+		return year > current.Year() || (year == current.Year() && time.Month(month) >= current.Month())
+	}
+}
+
+// cardSecurityCodeLength returns the expected card security code length for a card number.
+// American Express cards use a 4-digit code; all other or unrecognized numbers use 3 digits.
+func cardSecurityCodeLength(number string) int {
+	if brand, ok := CardBrand(number); ok && brand == "amex" {
+		return 4
+	}
+	return 3
+}
+
+// validateCVV validates card security codes (CVV/CVC/CID).
+// With a card number field parameter, the length follows the detected brand: 4 digits for
+// American Express, 3 digits otherwise. Without a parameter, 3 or 4 digits are accepted.
+// Usage:
+//   - `validate:"cvv=CardNumber"` - length based on the sibling CardNumber field
+//   - `validate:"cvv"` - 3 or 4 digits
+func validateCVV(fl validator.FieldLevel) bool {
+	minLength, maxLength := 3, 4
+	if param := fl.Param(); param != "" {
+		cardField, found := lookupFieldPath(fl.Parent(), param)
+		if !found {
+			panicConfigError(fl, "card number references a field that does not exist")
+		}
+
+		var number string
+		if cardField.IsValid() && cardField.Kind() == reflect.String {
+			number = cardField.String()
+		}
+		minLength = cardSecurityCodeLength(number)
+		maxLength = minLength
+	}
+
+	field := fl.Field()
+	if field.Kind() != reflect.String {
+		return false
+	}
+
+	code := field.String()
+	if len(code) < minLength || len(code) > maxLength {
+		return false
+	}
+	for i := 0; i < len(code); i++ {
+		if code[i] < '0' || code[i] > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+// abaRoutingValid reports whether s is a 9-digit ABA routing number with a valid Federal Reserve
+// prefix (00-12, 21-32, 61-72 or 80) and checksum: 3(d1+d4+d7) + 7(d2+d5+d8) + (d3+d6+d9) ≡ 0 (mod 10).
+func abaRoutingValid(s string) bool {
+	if len(s) != 9 {
+		return false
+	}
+	for i := 0; i < len(s); i++ {
+		if s[i] < '0' || s[i] > '9' {
+			return false
+		}
+	}
+
+	prefix := int(s[0]-'0')*10 + int(s[1]-'0')
+	switch {
+	case prefix <= 12, prefix >= 21 && prefix <= 32, prefix >= 61 && prefix <= 72, prefix == 80:
+	default:
+		return false
+	}
+
+	weights := [3]int{3, 7, 1}
+	sum := 0
+	for i := 0; i < len(s); i++ {
+		sum += int(s[i]-'0') * weights[i%3]
+	}
+	return sum%10 == 0
+}
+
+// validateABARouting validates US bank routing transit numbers (ABA RTN) used for ACH and wire transfers.
+// The value must be 9 digits with a valid Federal Reserve prefix and ABA checksum.
+// Usage: `validate:"aba_routing"`
+func validateABARouting(fl validator.FieldLevel) bool {
+	field := fl.Field()
+	if field.Kind() != reflect.String {
+		return false
+	}
+
+	return abaRoutingValid(field.String())
+}
+
+// validateVAT validates VAT and tax registration numbers by country.
+// The parameter is a space-separated list of country codes; "EU" expands to all EU member states.
+// EU and GB numbers must include their country prefix (e.g., "DE136695976"); Thai numbers are
+// 13-digit tax IDs without a prefix. Check digits are verified where the algorithm is public.
+// Without a parameter, any supported country is accepted.
+// Usage:
+//   - `validate:"vat=DE"` - German VAT number
+//   - `validate:"vat=TH EU"` - Thai tax ID or any EU VAT number
+//   - `validate:"vat"` - any supported country
+func validateVAT(fl validator.FieldLevel) bool {
+	countries := strings.Fields(fl.Param())
+	for _, country := range countries {
+		if _, ok := lookupVATFormat(country); !ok && country != "EU" {
+			panicConfigError(fl, "unsupported VAT country '"+country+"'")
+		}
+	}
+
+	field := fl.Field()
+	if field.Kind() != reflect.String {
+		return false
+	}
+	number := field.String()
+
+	if len(countries) == 0 {
+		for _, format := range vatFormats {
+			if vatNumberValid(number, format) {
+				return true
+			}
+		}
+		return false
+	}
+
+	for _, country := range countries {
+		if country == "EU" {
+			for _, member := range vatEUCountries {
+				if vatNumberValid(number, vatFormats[member]) {
+					return true
+				}
+			}
+			continue
+		}
+
+		format, _ := lookupVATFormat(country)
+		if vatNumberValid(number, format) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,468 @@
+package xvalidator
+
+import (
+	"testing"
+	"time"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateISO4217(t *testing.T) {
+	v := validator.New()
+	RegisterPaymentValidators(v)
+
+	tests := []struct {
+		name    string
+		value   interface{}
+		tag     string
+		wantErr bool
+	}{
+		{name: "valid THB", value: "THB", tag: "iso4217", wantErr: false},
+		{name: "valid USD", value: "USD", tag: "iso4217", wantErr: false},
+		{name: "valid zero minor unit JPY", value: "JPY", tag: "iso4217", wantErr: false},
+		{name: "valid precious metal XAU", value: "XAU", tag: "iso4217", wantErr: false},
+		{name: "valid special drawing right XDR", value: "XDR", tag: "iso4217", wantErr: false},
+		{name: "lowercase code", value: "usd", tag: "iso4217", wantErr: true},
+		{name: "unknown code", value: "ABC", tag: "iso4217", wantErr: true},
+		{name: "testing code XTS", value: "XTS", tag: "iso4217", wantErr: true},
+		{name: "no currency code XXX", value: "XXX", tag: "iso4217", wantErr: true},
+		{name: "retired code DEM", value: "DEM", tag: "iso4217", wantErr: true},
+		{name: "too long", value: "USDT", tag: "iso4217", wantErr: true},
+		{name: "empty string", value: "", tag: "iso4217", wantErr: true},
+		{name: "fund code rejected by default", value: "CLF", tag: "iso4217", wantErr: true},
+		{name: "fund code USN rejected by default", value: "USN", tag: "iso4217", wantErr: true},
+		{name: "fund code allowed with funds", value: "CLF", tag: "iso4217=funds", wantErr: false},
+		{name: "currency allowed with funds", value: "EUR", tag: "iso4217=funds", wantErr: false},
+		{name: "unknown code with funds", value: "ABC", tag: "iso4217=funds", wantErr: true},
+		{name: "non-string value", value: 840, tag: "iso4217", wantErr: true},
+		{name: "omitempty with empty string", value: "", tag: "omitempty,iso4217", wantErr: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := v.Var(tt.value, tt.tag)
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestValidateISO4217_InvalidParam(t *testing.T) {
+	v, err := NewValidator()
+	require.NoError(t, err)
+
+	err = v.Var("USD", "iso4217=all")
+
+	var configErr *ConfigError
+	require.ErrorAs(t, err, &configErr)
+	assert.Equal(t, "iso4217", configErr.Tag)
+	assert.Equal(t, "all", configErr.Param)
+}
+
+func TestIsCurrencyCode_TablesDisjoint(t *testing.T) {
+	for code := range currencyFundsCodes {
+		_, ok := currencyMinorUnits[code]
+		assert.True(t, ok, "fund code %s should have a minor unit entry", code)
+		_, ok = currencyNoMinorUnitCodes[code]
+		assert.False(t, ok, "fund code %s should not be listed as a no-minor-unit code", code)
+	}
+	for code := range currencyNoMinorUnitCodes {
+		_, ok := currencyMinorUnits[code]
+		assert.False(t, ok, "code %s should not have a minor unit entry", code)
+	}
+}
+
+func TestValidateCardNumber(t *testing.T) {
+	v := validator.New()
+	RegisterPaymentValidators(v)
+
+	tests := []struct {
+		name    string
+		value   interface{}
+		tag     string
+		wantErr bool
+	}{
+		{name: "valid visa", value: "4111111111111111", tag: "card_number", wantErr: false},
+		{name: "valid visa 13 digits", value: "4222222222222", tag: "card_number", wantErr: false},
+		{name: "valid mastercard", value: "5555555555554444", tag: "card_number", wantErr: false},
+		{name: "valid mastercard 2-series", value: "2223003122003222", tag: "card_number", wantErr: false},
+		{name: "valid amex", value: "378282246310005", tag: "card_number", wantErr: false},
+		{name: "valid luhn unknown brand", value: "9999999999999995", tag: "card_number", wantErr: false},
+		{name: "luhn failure", value: "4111111111111112", tag: "card_number", wantErr: true},
+		{name: "too short", value: "45321234", tag: "card_number", wantErr: true},
+		{name: "too long", value: "41111111111111111111", tag: "card_number", wantErr: true},
+		{name: "spaces not allowed", value: "4111 1111 1111 1111", tag: "card_number", wantErr: true},
+		{name: "hyphens not allowed", value: "4111-1111-1111-1111", tag: "card_number", wantErr: true},
+		{name: "empty string", value: "", tag: "card_number", wantErr: true},
+		{name: "non-string value", value: 4111111111111111, tag: "card_number", wantErr: true},
+		{name: "visa allowed", value: "4111111111111111", tag: "card_number=visa mastercard", wantErr: false},
+		{name: "mastercard allowed", value: "5555555555554444", tag: "card_number=visa mastercard", wantErr: false},
+		{name: "amex rejected", value: "378282246310005", tag: "card_number=visa mastercard", wantErr: true},
+		{name: "unknown brand rejected", value: "9999999999999995", tag: "card_number=visa mastercard", wantErr: true},
+		{name: "luhn failure with brand", value: "4111111111111112", tag: "card_number=visa", wantErr: true},
+		{name: "omitempty with empty string", value: "", tag: "omitempty,card_number", wantErr: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := v.Var(tt.value, tt.tag)
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestValidateCardNumber_InvalidParam(t *testing.T) {
+	v, err := NewValidator()
+	require.NoError(t, err)
+
+	err = v.Var("4111111111111111", "card_number=visa maestro")
+
+	var configErr *ConfigError
+	require.ErrorAs(t, err, &configErr)
+	assert.Equal(t, "card_number", configErr.Tag)
+	assert.Contains(t, configErr.Reason, "maestro")
+}
+
+func TestCardBrand(t *testing.T) {
+	tests := []struct {
+		number    string
+		wantBrand string
+		wantOK    bool
+	}{
+		{number: "4111111111111111", wantBrand: "visa", wantOK: true},
+		{number: "5555555555554444", wantBrand: "mastercard", wantOK: true},
+		{number: "2223003122003222", wantBrand: "mastercard", wantOK: true},
+		{number: "378282246310005", wantBrand: "amex", wantOK: true},
+		{number: "6011111111111117", wantBrand: "discover", wantOK: true},
+		{number: "6221260000000000", wantBrand: "discover", wantOK: true},
+		{number: "3530111333300000", wantBrand: "jcb", wantOK: true},
+		{number: "30569309025904", wantBrand: "diners", wantOK: true},
+		{number: "6200000000000005", wantBrand: "unionpay", wantOK: true},
+		{number: "5555555555554", wantBrand: "", wantOK: false},
+		{number: "9999999999999995", wantBrand: "", wantOK: false},
+		{number: "4111-1111", wantBrand: "", wantOK: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.number, func(t *testing.T) {
+			brand, ok := CardBrand(tt.number)
+			assert.Equal(t, tt.wantOK, ok)
+			assert.Equal(t, tt.wantBrand, brand)
+		})
+	}
+}
+
+func TestLuhnValid(t *testing.T) {
+	assert.True(t, luhnValid("79927398713"))
+	assert.True(t, luhnValid("6011111111111117"))
+	assert.True(t, luhnValid("3530111333300000"))
+	assert.True(t, luhnValid("30569309025904"))
+	assert.True(t, luhnValid("6200000000000005"))
+	assert.False(t, luhnValid("79927398710"))
+}
+
+func TestValidateCardExpiry(t *testing.T) {
+	v := validator.New()
+	registerPaymentValidators(v, options{now: func() time.Time {
+		return time.Date(2026, time.October, 16, 12, 0, 0, 0, time.UTC)
+	}})
+
+	type card struct {
+		ExpiryMonth string
+		ExpiryYear  string `validate:"card_expiry=ExpiryMonth:ExpiryYear"`
+	}
+
+	type numericCard struct {
+		Month int `validate:"card_expiry=Month:Year"`
+		Year  int
+	}
+
+	tests := []struct {
+		name    string
+		input   interface{}
+		wantErr bool
+	}{
+		{name: "future year", input: card{ExpiryMonth: "03", ExpiryYear: "28"}, wantErr: false},
+		{name: "current month", input: card{ExpiryMonth: "10", ExpiryYear: "26"}, wantErr: false},
+		{name: "later this year", input: card{ExpiryMonth: "12", ExpiryYear: "26"}, wantErr: false},
+		{name: "four-digit year", input: card{ExpiryMonth: "01", ExpiryYear: "2027"}, wantErr: false},
+		{name: "single-digit month", input: card{ExpiryMonth: "1", ExpiryYear: "27"}, wantErr: false},
+		{name: "previous month", input: card{ExpiryMonth: "09", ExpiryYear: "26"}, wantErr: true},
+		{name: "previous year", input: card{ExpiryMonth: "12", ExpiryYear: "25"}, wantErr: true},
+		{name: "month zero", input: card{ExpiryMonth: "00", ExpiryYear: "28"}, wantErr: true},
+		{name: "month thirteen", input: card{ExpiryMonth: "13", ExpiryYear: "28"}, wantErr: true},
+		{name: "non-numeric month", input: card{ExpiryMonth: "ab", ExpiryYear: "28"}, wantErr: true},
+		{name: "empty month", input: card{ExpiryMonth: "", ExpiryYear: "28"}, wantErr: true},
+		{name: "three-digit year", input: card{ExpiryMonth: "01", ExpiryYear: "202"}, wantErr: true},
+		{name: "signed year", input: card{ExpiryMonth: "01", ExpiryYear: "+28"}, wantErr: true},
+		{name: "integer fields", input: numericCard{Month: 11, Year: 2026}, wantErr: false},
+		{name: "integer two-digit year", input: numericCard{Month: 2, Year: 27}, wantErr: false},
+		{name: "integer past year", input: numericCard{Month: 11, Year: 2025}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := v.Struct(tt.input)
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestValidateCardExpiry_WithClock(t *testing.T) {
+	type card struct {
+		ExpiryMonth string
+		ExpiryYear  string `validate:"card_expiry=ExpiryMonth:ExpiryYear"`
+	}
+
+	input := card{ExpiryMonth: "06", ExpiryYear: "30"}
+
+	before, err := NewValidator(WithClock(func() time.Time {
+		return time.Date(2030, time.June, 30, 23, 59, 0, 0, time.UTC)
+	}))
+	require.NoError(t, err)
+	assert.NoError(t, before.Struct(input))
+
+	after, err := NewValidator(WithClock(func() time.Time {
+		return time.Date(2030, time.July, 1, 0, 0, 0, 0, time.UTC)
+	}))
+	require.NoError(t, err)
+	assert.Error(t, after.Struct(input))
+}
+
+func TestValidateCardExpiry_InvalidParam(t *testing.T) {
+	v, err := NewValidator()
+	require.NoError(t, err)
+
+	type missingSeparator struct {
+		ExpiryYear string `validate:"card_expiry=ExpiryMonth"`
+	}
+	type missingField struct {
+		ExpiryYear string `validate:"card_expiry=Month:ExpiryYear"`
+	}
+
+	tests := []struct {
+		name   string
+		input  interface{}
+		reason string
+	}{
+		{name: "missing separator", input: missingSeparator{ExpiryYear: "28"}, reason: "expected format MonthField:YearField"},
+		{name: "missing field", input: missingField{ExpiryYear: "28"}, reason: "expiry references a field that does not exist"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := v.Struct(tt.input)
+
+			var configErr *ConfigError
+			require.ErrorAs(t, err, &configErr)
+			assert.Equal(t, "card_expiry", configErr.Tag)
+			assert.Equal(t, tt.reason, configErr.Reason)
+		})
+	}
+}
+
+func TestValidateCVV(t *testing.T) {
+	v := validator.New()
+	RegisterPaymentValidators(v)
+
+	type card struct {
+		CardNumber string
+		CVV        string `validate:"cvv=CardNumber"`
+	}
+
+	tests := []struct {
+		name    string
+		input   card
+		wantErr bool
+	}{
+		{name: "visa 3 digits", input: card{CardNumber: "4111111111111111", CVV: "123"}, wantErr: false},
+		{name: "visa 4 digits", input: card{CardNumber: "4111111111111111", CVV: "1234"}, wantErr: true},
+		{name: "amex 4 digits", input: card{CardNumber: "378282246310005", CVV: "1234"}, wantErr: false},
+		{name: "amex 3 digits", input: card{CardNumber: "378282246310005", CVV: "123"}, wantErr: true},
+		{name: "unknown brand 3 digits", input: card{CardNumber: "9999999999999995", CVV: "123"}, wantErr: false},
+		{name: "empty card number 3 digits", input: card{CardNumber: "", CVV: "123"}, wantErr: false},
+		{name: "non-digit code", input: card{CardNumber: "4111111111111111", CVV: "12a"}, wantErr: true},
+		{name: "empty code", input: card{CardNumber: "4111111111111111", CVV: ""}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := v.Struct(tt.input)
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestValidateCVV_WithoutParam(t *testing.T) {
+	v := validator.New()
+	RegisterPaymentValidators(v)
+
+	assert.NoError(t, v.Var("123", "cvv"))
+	assert.NoError(t, v.Var("1234", "cvv"))
+	assert.Error(t, v.Var("12", "cvv"))
+	assert.Error(t, v.Var("12345", "cvv"))
+	assert.Error(t, v.Var(123, "cvv"))
+}
+
+func TestValidateCVV_InvalidParam(t *testing.T) {
+	v, err := NewValidator()
+	require.NoError(t, err)
+
+	type card struct {
+		CVV string `validate:"cvv=Number"`
+	}
+
+	err = v.Struct(card{CVV: "123"})
+
+	var configErr *ConfigError
+	require.ErrorAs(t, err, &configErr)
+	assert.Equal(t, "cvv", configErr.Tag)
+	assert.Equal(t, "card number references a field that does not exist", configErr.Reason)
+}
+
+func TestValidateABARouting(t *testing.T) {
+	v := validator.New()
+	RegisterPaymentValidators(v)
+
+	tests := []struct {
+		name    string
+		value   interface{}
+		wantErr bool
+	}{
+		{name: "valid federal reserve bank", value: "011000015", wantErr: false},
+		{name: "valid commercial bank", value: "021000021", wantErr: false},
+		{name: "valid west coast bank", value: "121000358", wantErr: false},
+		{name: "valid thrift prefix", value: "322271627", wantErr: false},
+		{name: "checksum failure", value: "021000022", wantErr: true},
+		{name: "invalid prefix", value: "500000005", wantErr: true},
+		{name: "too short", value: "02100002", wantErr: true},
+		{name: "too long", value: "0210000210", wantErr: true},
+		{name: "non-digit", value: "02100002a", wantErr: true},
+		{name: "hyphenated", value: "0210-0002", wantErr: true},
+		{name: "empty string", value: "", wantErr: true},
+		{name: "non-string value", value: 21000021, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := v.Var(tt.value, "aba_routing")
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestValidateVAT(t *testing.T) {
+	v := validator.New()
+	RegisterPaymentValidators(v)
+
+	tests := []struct {
+		name    string
+		value   interface{}
+		tag     string
+		wantErr bool
+	}{
+		{name: "valid german", value: "DE136695976", tag: "vat=DE", wantErr: false},
+		{name: "german check digit failure", value: "DE136695977", tag: "vat=DE", wantErr: true},
+		{name: "german without prefix", value: "136695976", tag: "vat=DE", wantErr: true},
+		{name: "german lowercase prefix", value: "de136695976", tag: "vat=DE", wantErr: true},
+		{name: "valid austrian format", value: "ATU12345678", tag: "vat=AT", wantErr: false},
+		{name: "austrian missing U", value: "AT12345678", tag: "vat=AT", wantErr: true},
+		{name: "valid greek with EL prefix", value: "EL123456789", tag: "vat=GR", wantErr: false},
+		{name: "valid thai tax id", value: "0105536112014", tag: "vat=TH", wantErr: false},
+		{name: "thai check digit failure", value: "0105536112015", tag: "vat=TH", wantErr: true},
+		{name: "valid uk", value: "GB980780684", tag: "vat=GB", wantErr: false},
+		{name: "valid uk government department", value: "GBGD001", tag: "vat=GB", wantErr: false},
+		{name: "uk check digit failure", value: "GB980780685", tag: "vat=GB", wantErr: true},
+		{name: "eu group accepts member", value: "NL004495445B01", tag: "vat=EU", wantErr: false},
+		{name: "eu group rejects uk", value: "GB980780684", tag: "vat=EU", wantErr: true},
+		{name: "multiple countries thai", value: "0105536112014", tag: "vat=TH EU", wantErr: false},
+		{name: "multiple countries eu", value: "IT00743110157", tag: "vat=TH EU", wantErr: false},
+		{name: "country not in list", value: "DE136695976", tag: "vat=FR IT", wantErr: true},
+		{name: "any supported country", value: "PL5260250274", tag: "vat", wantErr: false},
+		{name: "any supported country failure", value: "XX123456789", tag: "vat", wantErr: true},
+		{name: "empty string", value: "", tag: "vat=DE", wantErr: true},
+		{name: "non-string value", value: 136695976, tag: "vat=DE", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := v.Var(tt.value, tt.tag)
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestValidateVAT_InvalidParam(t *testing.T) {
+	v, err := NewValidator()
+	require.NoError(t, err)
+
+	err = v.Var("DE136695976", "vat=DE US")
+
+	var configErr *ConfigError
+	require.ErrorAs(t, err, &configErr)
+	assert.Equal(t, "vat", configErr.Tag)
+	assert.Equal(t, "unsupported VAT country 'US'", configErr.Reason)
+}
+
+func TestVATCheckDigits(t *testing.T) {
+	tests := []struct {
+		name   string
+		number string
+		valid  bool
+	}{
+		{name: "BE valid", number: "BE0403170701", valid: true},
+		{name: "BE invalid", number: "BE0403170702", valid: false},
+		{name: "DK valid", number: "DK13585628", valid: true},
+		{name: "DK invalid", number: "DK13585629", valid: false},
+		{name: "FI valid", number: "FI20774740", valid: true},
+		{name: "FI invalid", number: "FI20774741", valid: false},
+		{name: "FR numeric key valid", number: "FR40303265045", valid: true},
+		{name: "FR numeric key invalid", number: "FR41303265045", valid: false},
+		{name: "FR alphanumeric key", number: "FRK7399859412", valid: true},
+		{name: "IT valid", number: "IT00743110157", valid: true},
+		{name: "IT invalid", number: "IT00743110158", valid: false},
+		{name: "NL mod 11 valid", number: "NL004495445B01", valid: true},
+		{name: "NL mod 97 valid", number: "NL000099998B57", valid: true},
+		{name: "NL invalid", number: "NL000099998B58", valid: false},
+		{name: "PL valid", number: "PL5252248481", valid: true},
+		{name: "PL invalid", number: "PL5252248482", valid: false},
+		{name: "PT valid", number: "PT501964843", valid: true},
+		{name: "PT invalid", number: "PT501964844", valid: false},
+		{name: "SE valid", number: "SE556188840401", valid: true},
+		{name: "SE invalid", number: "SE556188840501", valid: false},
+		{name: "SE wrong suffix", number: "SE556188840402", valid: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			format, ok := lookupVATFormat(tt.number[:2])
+			require.True(t, ok)
+			assert.Equal(t, tt.valid, vatNumberValid(tt.number, format))
+		})
+	}
+}
@@ -0,0 +1,321 @@
+package xvalidator
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/nyaruka/phonenumbers"
+)
+
+// Phone validation logic functions
+
+// phoneRegions holds the region filter parsed from a phone rule parameter.
+// An empty filter accepts every region.
+type phoneRegions struct {
+	allow []string
+	deny  []string
+}
+
+// parsePhoneRegions parses a space-separated list of region codes such as "TH" or "!RU !KP".
+// Codes prefixed with "!" are denied; when plain codes are present, the region must be one of them.
+func parsePhoneRegions(fl validator.FieldLevel) phoneRegions {
+	var regions phoneRegions
+	for _, code := range strings.Fields(fl.Param()) {
+		denied := strings.HasPrefix(code, "!")
+		code = strings.TrimPrefix(code, "!")
+		if code == "" || strings.HasPrefix(code, "!") {
+			panicConfigError(fl, "expected region codes such as 'TH' or '!RU'")
+		}
+		if denied {
+			regions.deny = append(regions.deny, code)
+		} else {
+			regions.allow = append(regions.allow, code)
+		}
+	}
+	return regions
+}
+
+// allows reports whether the region passes the allow and deny lists.
+func (r phoneRegions) allows(region string) bool {
+	for _, code := range r.deny {
+		if code == region {
+			return false
+		}
+	}
+	if len(r.allow) == 0 {
+		return true
+	}
+	for _, code := range r.allow {
+		if code == region {
+			return true
+		}
+	}
+	return false
+}
+
+// e164Number is a parsed E.164 phone number together with the libphonenumber lookups the rules need.
+// Values are shared through phoneParseCache and must not be modified.
+type e164Number struct {
+	num        *phonenumbers.PhoneNumber
+	valid      bool
+	numberType phonenumbers.PhoneNumberType
+	region     string
+}
+
+// phoneParseCacheSize bounds the number of raw phone strings kept in phoneParseCache.
+const phoneParseCacheSize = 4096
+
+// phoneParseCache caches parseE164Number results keyed by the raw input, since bulk imports
+// re-validate the same numbers and phonenumbers.Parse is comparatively expensive.
+// Only inputs matching E164Regex are cached, so arbitrary strings cannot evict real numbers.
+var phoneParseCache = newLRUCache[string, e164Number](phoneParseCacheSize)
+
+// lookupE164Number parses an E.164 number such as "+66812345678", using phoneParseCache.
+// num is nil when the input is not E.164 or cannot be parsed; valid reports full libphonenumber validity.
+func lookupE164Number(phone string) e164Number {
+	var parsed e164Number
+	// The regex check runs first so obviously malformed input never reaches libphonenumber or the cache
+	if !E164Regex().MatchString(phone) {
+		return parsed
+	}
+	if cached, ok := phoneParseCache.Get(phone); ok {
+		return cached
+	}
+
+	// Parse without a default region; the country calling code determines it
+	if num, err := phonenumbers.Parse(phone, ""); err == nil {
+		parsed.num = num
+		parsed.valid = phonenumbers.IsValidNumber(num)
+		parsed.region = phonenumbers.GetRegionCodeForNumber(num)
+		if parsed.valid {
+			parsed.numberType = phonenumbers.GetNumberType(num)
+		} else {
+			parsed.numberType = phonenumbers.UNKNOWN
+		}
+	}
+
+	phoneParseCache.Add(phone, parsed)
+	return parsed
+}
+
+// parseE164Number parses an E.164 number such as "+66812345678" and reports whether it is a valid number.
+func parseE164Number(phone string) (e164Number, bool) {
+	parsed := lookupE164Number(phone)
+	return parsed, parsed.valid
+}
+
+// PhoneInfo describes a validated phone number as returned by ParsePhone.
+type PhoneInfo struct {
+	// Region is the ISO 3166-1 alpha-2 region of the number (e.g., "TH").
+	Region string
+	// E164 is the number in E.164 format (e.g., "+66812345678").
+	E164 string
+	// National is the number in the region's national format (e.g., "081 234 5678").
+	National string
+	// Type is the number type: "mobile", "landline", "fixed_line_or_mobile", "toll_free", "premium_rate",
+	// "shared_cost", "voip", "personal_number", "pager", "uan", "voicemail" or "unknown".
+	Type string
+}
+
+// phoneNumberTypeNames maps libphonenumber number types to the names used in PhoneInfo.Type.
+var phoneNumberTypeNames = map[phonenumbers.PhoneNumberType]string{
+	phonenumbers.FIXED_LINE:           "landline",
+	phonenumbers.MOBILE:               "mobile",
+	phonenumbers.FIXED_LINE_OR_MOBILE: "fixed_line_or_mobile",
+	phonenumbers.TOLL_FREE:            "toll_free",
+	phonenumbers.PREMIUM_RATE:         "premium_rate",
+	phonenumbers.SHARED_COST:          "shared_cost",
+	phonenumbers.VOIP:                 "voip",
+	phonenumbers.PERSONAL_NUMBER:      "personal_number",
+	phonenumbers.PAGER:                "pager",
+	phonenumbers.UAN:                  "uan",
+	phonenumbers.VOICEMAIL:            "voicemail",
+}
+
+// ParsePhone validates an E.164 phone number the same way as phone_e164 and returns its region,
+// E.164 and national forms, and number type, so callers don't need to re-parse it with libphonenumber.
+func ParsePhone(number string) (PhoneInfo, error) {
+	num, ok := parseE164Number(number)
+	if !ok {
+		return PhoneInfo{}, fmt.Errorf("xvalidator: %q is not a valid phone number in E.164 format", number)
+	}
+
+	numberType, ok := phoneNumberTypeNames[num.numberType]
+	if !ok {
+		numberType = "unknown"
+	}
+
+	return PhoneInfo{
+		Region:   num.region,
+		E164:     phonenumbers.Format(num.num, phonenumbers.E164),
+		National: phonenumbers.Format(num.num, phonenumbers.NATIONAL),
+		Type:     numberType,
+	}, nil
+}
+
+// validateMobileE164Possible is a lenient variant of mobile_e164 for markets where libphonenumber metadata
+// lags newly allocated prefixes. The number only needs a plausible length for its country calling code
+// (IsPossibleNumber); numbers the metadata does recognise must still be mobile numbers.
+// Supports the same region parameter as mobile_e164:
+//   - mobile_e164_possible (no param): accepts any country
+//   - mobile_e164_possible=TH: accepts Thailand numbers only
+//   - mobile_e164_possible=!RU !KP: accepts any country except RU and KP
+func validateMobileE164Possible(fl validator.FieldLevel) bool {
+	regions := parsePhoneRegions(fl)
+	parsed := lookupE164Number(fl.Field().String())
+	if parsed.num == nil || !phonenumbers.IsPossibleNumber(parsed.num) {
+		return false
+	}
+
+	regionCode := parsed.region
+	if parsed.valid {
+		if parsed.numberType != phonenumbers.MOBILE && parsed.numberType != phonenumbers.FIXED_LINE_OR_MOBILE {
+			return false
+		}
+	} else if regionCode == "" {
+		// Unknown numbers in shared calling codes (e.g., +1) fall back to the main region
+		regionCode = phonenumbers.GetRegionCodeForCountryCode(int(parsed.num.GetCountryCode()))
+	}
+	return regions.allows(regionCode)
+}
+
+// validateLandlineE164 validates that the phone number is in E.164 format and is a fixed-line number.
+// Numbers libphonenumber cannot tell apart from mobiles (FIXED_LINE_OR_MOBILE, e.g. US and CA) are accepted.
+// Supports the same region parameter as mobile_e164:
+//   - landline_e164 (no param): validates any country landline
+//   - landline_e164=TH: validates Thailand landline numbers only
+//   - landline_e164=!RU !KP: validates landlines from any country except RU and KP
+func validateLandlineE164(fl validator.FieldLevel) bool {
+	regions := parsePhoneRegions(fl)
+	num, ok := parseE164Number(fl.Field().String())
+	if !ok {
+		return false
+	}
+
+	numberType := num.numberType
+	if numberType != phonenumbers.FIXED_LINE && numberType != phonenumbers.FIXED_LINE_OR_MOBILE {
+		return false
+	}
+	return regions.allows(num.region)
+}
+
+// validatePhoneE164 validates that the phone number is in E.164 format and is a valid number of any type,
+// including VOIP, toll-free and shared-cost numbers.
+// Supports the same region parameter as mobile_e164:
+//   - phone_e164 (no param): validates any country number
+//   - phone_e164=TH: validates Thailand numbers only
+//   - phone_e164=!RU !KP: validates numbers from any country except RU and KP
+func validatePhoneE164(fl validator.FieldLevel) bool {
+	regions := parsePhoneRegions(fl)
+	num, ok := parseE164Number(fl.Field().String())
+	if !ok {
+		return false
+	}
+	return regions.allows(num.region)
+}
+
+// validatePhoneNoPremium validates that an E.164 phone number is not a costly callback number.
+// PREMIUM_RATE and SHARED_COST numbers are always rejected; TOLL_FREE numbers are rejected when requested.
+// Numbers that are not valid E.164 numbers are rejected too, since their type cannot be determined.
+// Usage:
+//   - `validate:"phone_no_premium"` - reject premium-rate and shared-cost numbers
+//   - `validate:"phone_no_premium=toll_free"` - also reject toll-free numbers
+func validatePhoneNoPremium(fl validator.FieldLevel) bool {
+	var rejectTollFree bool
+	switch fl.Param() {
+	case "":
+	case "toll_free":
+		rejectTollFree = true
+	default:
+		panicConfigError(fl, "expected no parameter or 'toll_free'")
+	}
+
+	num, ok := parseE164Number(fl.Field().String())
+	if !ok {
+		return false
+	}
+
+	switch num.numberType {
+	case phonenumbers.PREMIUM_RATE, phonenumbers.SHARED_COST:
+		return false
+	case phonenumbers.TOLL_FREE:
+		return !rejectTollFree
+	default:
+		return true
+	}
+}
+
+// validatePhoneRegionField validates that an E.164 phone number belongs to the country stored in a sibling field.
+// The country field holds an ISO 3166-1 alpha-2 or alpha-3 code (e.g., "TH" or "THA").
+// Usage:
+//   - `validate:"phone_region_field=Country"` - region of the number must equal the Country field
+//   - `validate:"phone_region_field=Address.Country"` - nested fields are supported
+func validatePhoneRegionField(fl validator.FieldLevel) bool {
+	countryField, found := lookupFieldPath(fl.Parent(), fl.Param())
+	if !found {
+		panicConfigError(fl, "country references a field that does not exist")
+	}
+	if !countryField.IsValid() || countryField.Kind() != reflect.String {
+		return false
+	}
+
+	country := countryField.String()
+	if alpha2, ok := CountryAlpha2(country); ok {
+		country = alpha2
+	}
+
+	num, ok := parseE164Number(fl.Field().String())
+	if !ok {
+		return false
+	}
+	return num.region == country
+}
+
+// validateThaiPhone validates Thai phone numbers written in local format (0 followed by 8 or 9 digits,
+// e.g., "0812345678" or "021234567") using libphonenumber metadata for region TH.
+// International forms such as "+66812345678" are rejected; use mobile_e164=TH for those.
+// Usage:
+//   - `validate:"thai_phone"` - mobile or landline
+//   - `validate:"thai_phone=mobile"` - mobile only
+//   - `validate:"thai_phone=landline"` - landline only
+func validateThaiPhone(fl validator.FieldLevel) bool {
+	var allowMobile, allowLandline bool
+	switch fl.Param() {
+	case "":
+		allowMobile, allowLandline = true, true
+	case "mobile":
+		allowMobile = true
+	case "landline":
+		allowLandline = true
+	default:
+		panicConfigError(fl, "expected no parameter, 'mobile' or 'landline'")
+	}
+
+	field := fl.Field()
+	if field.Kind() != reflect.String {
+		return false
+	}
+
+	phone := field.String()
+	if len(phone) < 9 || len(phone) > 10 || phone[0] != '0' || !isASCIIDigits(phone) {
+		return false
+	}
+
+	num, err := phonenumbers.Parse(phone, "TH")
+	if err != nil || !phonenumbers.IsValidNumberForRegion(num, "TH") {
+		return false
+	}
+
+	switch phonenumbers.GetNumberType(num) {
+	case phonenumbers.MOBILE:
+		return allowMobile
+	case phonenumbers.FIXED_LINE:
+		return allowLandline
+	case phonenumbers.FIXED_LINE_OR_MOBILE:
+		return true
+	default:
+		return false
+	}
+}
@@ -3,6 +3,7 @@ package xvalidator
 import (
 	"testing"
 
+	"github.com/nyaruka/phonenumbers"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -303,3 +304,400 @@ func TestMobileE164CountrySpecific(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateThaiPhone(t *testing.T) {
+	v, err := NewValidator()
+	require.NoError(t, err)
+
+	tests := []struct {
+		name    string
+		value   interface{}
+		tag     string
+		wantErr bool
+	}{
+		{name: "mobile 08", value: "0812345678", tag: "thai_phone", wantErr: false},
+		{name: "mobile 09", value: "0912345678", tag: "thai_phone", wantErr: false},
+		{name: "mobile 06", value: "0612345678", tag: "thai_phone", wantErr: false},
+		{name: "bangkok landline", value: "021234567", tag: "thai_phone", wantErr: false},
+		{name: "provincial landline", value: "053123456", tag: "thai_phone", wantErr: false},
+		{name: "invalid prefix", value: "0112345678", tag: "thai_phone", wantErr: true},
+		{name: "mobile too short", value: "081234567", tag: "thai_phone", wantErr: true},
+		{name: "too long", value: "08123456789", tag: "thai_phone", wantErr: true},
+		{name: "international format", value: "+66812345678", tag: "thai_phone", wantErr: true},
+		{name: "with hyphens", value: "081-234-5678", tag: "thai_phone", wantErr: true},
+		{name: "missing leading zero", value: "812345678", tag: "thai_phone", wantErr: true},
+		{name: "empty string", value: "", tag: "thai_phone", wantErr: true},
+		{name: "non-string value", value: 812345678, tag: "thai_phone", wantErr: true},
+		{name: "mobile only accepts mobile", value: "0812345678", tag: "thai_phone=mobile", wantErr: false},
+		{name: "mobile only rejects landline", value: "021234567", tag: "thai_phone=mobile", wantErr: true},
+		{name: "landline only accepts landline", value: "021234567", tag: "thai_phone=landline", wantErr: false},
+		{name: "landline only rejects mobile", value: "0812345678", tag: "thai_phone=landline", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := v.Var(tt.value, tt.tag)
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestValidateThaiPhone_InvalidParam(t *testing.T) {
+	v, err := NewValidator()
+	require.NoError(t, err)
+
+	err = v.Var("0812345678", "thai_phone=voip")
+
+	var configErr *ConfigError
+	require.ErrorAs(t, err, &configErr)
+	assert.Equal(t, "thai_phone", configErr.Tag)
+}
+
+// TestMobileE164RegionDenylist tests the negated region syntax of mobile_e164.
+func TestMobileE164RegionDenylist(t *testing.T) {
+	v, err := NewValidator()
+	require.NoError(t, err)
+
+	tests := []struct {
+		name    string
+		value   string
+		tag     string
+		wantErr bool
+	}{
+		{"thai_mobile_not_denied", "+66812345678", "mobile_e164=!RU !KP", false},
+		{"uk_mobile_not_denied", "+447912345678", "mobile_e164=!RU !KP", false},
+		{"russian_mobile_denied", "+79123456789", "mobile_e164=!RU !KP", true},
+		{"russian_mobile_allowed_without_denylist", "+79123456789", "mobile_e164", false},
+		{"single_denied_region", "+66812345678", "mobile_e164=!TH", true},
+		{"allow_and_deny_combined", "+66812345678", "mobile_e164=TH !RU", false},
+		{"allow_list_multiple_regions", "+447912345678", "mobile_e164=TH GB", false},
+		{"outside_allow_list", "+79123456789", "mobile_e164=TH GB", true},
+		{"denylist_still_requires_mobile", "+6621234567", "mobile_e164=!RU", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := v.Var(tt.value, tt.tag)
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+// TestMobileE164RegionDenylist_InvalidParam tests that a bare "!" is reported as a ConfigError.
+func TestMobileE164RegionDenylist_InvalidParam(t *testing.T) {
+	v, err := NewValidator()
+	require.NoError(t, err)
+
+	var configErr *ConfigError
+	err = v.Var("+66812345678", "mobile_e164=!")
+	require.ErrorAs(t, err, &configErr)
+	assert.Equal(t, "mobile_e164", configErr.Tag)
+}
+
+// TestLandlineE164 tests the landline_e164 validation rule.
+func TestLandlineE164(t *testing.T) {
+	v, err := NewValidator()
+	require.NoError(t, err)
+
+	tests := []struct {
+		name    string
+		value   string
+		tag     string
+		wantErr bool
+	}{
+		{"thai_landline", "+6621234567", "landline_e164", false},
+		{"thai_landline_with_TH_param", "+6621234567", "landline_e164=TH", false},
+		{"uk_landline", "+442071838750", "landline_e164", false},
+		{"us_fixed_line_or_mobile", "+12015550123", "landline_e164=US", false},
+		{"thai_mobile_rejected", "+66812345678", "landline_e164", true},
+		{"thai_landline_with_GB_param", "+6621234567", "landline_e164=GB", true},
+		{"thai_landline_denied", "+6621234567", "landline_e164=!TH", true},
+		{"local_format_rejected", "021234567", "landline_e164", true},
+		{"invalid_number", "+660000000", "landline_e164", true},
+		{"empty_string", "", "landline_e164", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := v.Var(tt.value, tt.tag)
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+// TestPhoneE164 tests the phone_e164 validation rule.
+func TestPhoneE164(t *testing.T) {
+	v, err := NewValidator()
+	require.NoError(t, err)
+
+	tests := []struct {
+		name    string
+		value   string
+		tag     string
+		wantErr bool
+	}{
+		{"thai_mobile", "+66812345678", "phone_e164", false},
+		{"thai_landline", "+6621234567", "phone_e164", false},
+		{"us_toll_free", "+18002345678", "phone_e164", false},
+		{"uk_voip", "+445612345678", "phone_e164=GB", false},
+		{"thai_number_with_TH_param", "+6621234567", "phone_e164=TH", false},
+		{"thai_number_with_US_param", "+6621234567", "phone_e164=US", true},
+		{"thai_number_denied", "+66812345678", "phone_e164=!TH", true},
+		{"invalid_number", "+660000000", "phone_e164", true},
+		{"missing_plus", "66812345678", "phone_e164", true},
+		{"empty_string", "", "phone_e164", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := v.Var(tt.value, tt.tag)
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+// TestPhoneNoPremium tests the phone_no_premium validation rule.
+func TestPhoneNoPremium(t *testing.T) {
+	v, err := NewValidator()
+	require.NoError(t, err)
+
+	tests := []struct {
+		name    string
+		value   string
+		tag     string
+		wantErr bool
+	}{
+		{"mobile_allowed", "+66812345678", "phone_no_premium", false},
+		{"landline_allowed", "+6621234567", "phone_no_premium", false},
+		{"voip_allowed", "+445612345678", "phone_no_premium", false},
+		{"toll_free_allowed_by_default", "+18002345678", "phone_no_premium", false},
+		{"us_premium_rate_rejected", "+19002345678", "phone_no_premium", true},
+		{"thai_premium_rate_rejected", "+661900123456", "phone_no_premium", true},
+		{"spanish_shared_cost_rejected", "+34901123456", "phone_no_premium", true},
+		{"toll_free_rejected_with_param", "+18002345678", "phone_no_premium=toll_free", true},
+		{"mobile_allowed_with_param", "+66812345678", "phone_no_premium=toll_free", false},
+		{"invalid_number_rejected", "+660000000", "phone_no_premium", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := v.Var(tt.value, tt.tag)
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+// TestPhoneNoPremium_InvalidParam tests that an unknown parameter is reported as a ConfigError.
+func TestPhoneNoPremium_InvalidParam(t *testing.T) {
+	v, err := NewValidator()
+	require.NoError(t, err)
+
+	var configErr *ConfigError
+	err = v.Var("+66812345678", "phone_no_premium=voip")
+	require.ErrorAs(t, err, &configErr)
+	assert.Equal(t, "phone_no_premium", configErr.Tag)
+}
+
+// TestPhoneRegionField tests the phone_region_field cross-field validation rule.
+func TestPhoneRegionField(t *testing.T) {
+	v, err := NewValidator()
+	require.NoError(t, err)
+
+	type Address struct {
+		Country string
+	}
+	type Shipping struct {
+		Country string
+		Phone   string `validate:"phone_region_field=Country"`
+	}
+	type NestedShipping struct {
+		Address *Address
+		Phone   string `validate:"phone_region_field=Address.Country"`
+	}
+
+	tests := []struct {
+		name    string
+		data    interface{}
+		wantErr bool
+	}{
+		{"thai_number_with_TH", Shipping{Country: "TH", Phone: "+66812345678"}, false},
+		{"thai_number_with_alpha3", Shipping{Country: "THA", Phone: "+6621234567"}, false},
+		{"canadian_number_with_CA", Shipping{Country: "CA", Phone: "+16135550123"}, false},
+		{"canadian_number_with_US", Shipping{Country: "US", Phone: "+16135550123"}, true},
+		{"thai_number_with_US", Shipping{Country: "US", Phone: "+66812345678"}, true},
+		{"empty_country", Shipping{Country: "", Phone: "+66812345678"}, true},
+		{"invalid_number", Shipping{Country: "TH", Phone: "0812345678"}, true},
+		{"nested_country", NestedShipping{Address: &Address{Country: "GB"}, Phone: "+447912345678"}, false},
+		{"nil_nested_country", NestedShipping{Phone: "+447912345678"}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := v.Validate(tt.data)
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+// TestPhoneRegionField_InvalidParam tests that a missing country field is reported as a ConfigError.
+func TestPhoneRegionField_InvalidParam(t *testing.T) {
+	v, err := NewValidator()
+	require.NoError(t, err)
+
+	type Shipping struct {
+		Phone string `validate:"phone_region_field=Country"`
+	}
+
+	var configErr *ConfigError
+	err = v.Validate(Shipping{Phone: "+66812345678"})
+	require.ErrorAs(t, err, &configErr)
+	assert.Equal(t, "phone_region_field", configErr.Tag)
+}
+
+// TestMobileE164Possible tests the lenient mobile_e164_possible validation rule.
+func TestMobileE164Possible(t *testing.T) {
+	v, err := NewValidator()
+	require.NoError(t, err)
+
+	tests := []struct {
+		name    string
+		value   string
+		tag     string
+		wantErr bool
+	}{
+		{"valid_thai_mobile", "+66812345678", "mobile_e164_possible", false},
+		{"unallocated_thai_prefix_accepted", "+66512345678", "mobile_e164_possible", false},
+		{"unallocated_thai_prefix_rejected_by_strict_rule", "+66512345678", "mobile_e164", true},
+		{"known_landline_rejected", "+6621234567", "mobile_e164_possible", true},
+		{"impossible_length_rejected", "+3361234567890", "mobile_e164_possible", true},
+		{"unknown_nanp_number_falls_back_to_US", "+12005550123", "mobile_e164_possible=US", false},
+		{"region_param_matches", "+66512345678", "mobile_e164_possible=TH", false},
+		{"region_param_mismatch", "+66512345678", "mobile_e164_possible=GB", true},
+		{"region_denied", "+66512345678", "mobile_e164_possible=!TH", true},
+		{"missing_plus", "66812345678", "mobile_e164_possible", true},
+		{"empty_string", "", "mobile_e164_possible", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := v.Var(tt.value, tt.tag)
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+// TestParsePhone tests the ParsePhone helper.
+func TestParsePhone(t *testing.T) {
+	tests := []struct {
+		name    string
+		number  string
+		want    PhoneInfo
+		wantErr bool
+	}{
+		{
+			name:   "thai_mobile",
+			number: "+66812345678",
+			want:   PhoneInfo{Region: "TH", E164: "+66812345678", National: "081 234 5678", Type: "mobile"},
+		},
+		{
+			name:   "thai_landline",
+			number: "+6621234567",
+			want:   PhoneInfo{Region: "TH", E164: "+6621234567", National: "02 123 4567", Type: "landline"},
+		},
+		{
+			name:   "us_toll_free",
+			number: "+18002345678",
+			want:   PhoneInfo{Region: "US", E164: "+18002345678", National: "(800) 234-5678", Type: "toll_free"},
+		},
+		{name: "local_format", number: "0812345678", wantErr: true},
+		{name: "invalid_number", number: "+660000000", wantErr: true},
+		{name: "empty_string", number: "", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParsePhone(tt.number)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+// TestLookupE164NumberCache tests that cached parse results match fresh ones.
+func TestLookupE164NumberCache(t *testing.T) {
+	for _, phone := range []string{"+66812345678", "+6621234567", "+660000000"} {
+		first := lookupE164Number(phone)
+		_, cached := phoneParseCache.Get(phone)
+		assert.True(t, cached, "expected %q to be cached", phone)
+
+		second := lookupE164Number(phone)
+		assert.Equal(t, first, second)
+	}
+
+	// Input that is not E.164 is rejected before reaching the cache
+	for _, phone := range []string{"0812345678", "", "+66 81 234 5678", "not a phone number"} {
+		assert.Nil(t, lookupE164Number(phone).num)
+		_, cached := phoneParseCache.Get(phone)
+		assert.False(t, cached, "expected %q not to be cached", phone)
+	}
+}
+
+func BenchmarkMobileE164(b *testing.B) {
+	phones := []string{"+66812345678", "+447912345678", "+33612345678", "+12015550123"}
+
+	b.Run("uncached", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			phone := phones[i%len(phones)]
+			if !E164Regex().MatchString(phone) {
+				b.Fatal("unexpected regex mismatch")
+			}
+			num, err := phonenumbers.Parse(phone, "")
+			if err != nil || !phonenumbers.IsValidNumber(num) {
+				b.Fatal("unexpected invalid number")
+			}
+			phonenumbers.GetRegionCodeForNumber(num)
+			phonenumbers.GetNumberType(num)
+		}
+	})
+	b.Run("cached", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			if _, ok := parseE164Number(phones[i%len(phones)]); !ok {
+				b.Fatal("unexpected invalid number")
+			}
+		}
+	})
+}
@@ -0,0 +1,232 @@
+package xvalidator
+
+import (
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/go-playground/validator/v10"
+)
+
+// Product code validation logic functions
+
+// gs1CheckValid reports whether a digit string ends in a valid GS1 mod 10 check digit, as used by EAN-8,
+// EAN-13, UPC-A, GTIN-14 and ISBN-13: digits are weighted 3 and 1 alternately from the right, excluding the
+// check digit, and the check digit brings the sum to a multiple of 10.
+func gs1CheckValid(digits string) bool {
+	sum := 0
+	for i := len(digits) - 1; i >= 0; i-- {
+		digit := int(digits[i] - '0')
+		if (len(digits)-1-i)%2 == 1 {
+			digit *= 3
+		}
+		sum += digit
+	}
+	return sum%10 == 0
+}
+
+// isbn10CheckValid reports whether ten ISBN-10 characters, nine digits and a digit or X check character,
+// have a weighted sum (10 down to 1) that is a multiple of 11.
+func isbn10CheckValid(isbn string) bool {
+	sum := 0
+	for i := 0; i < 9; i++ {
+		sum += (10 - i) * int(isbn[i]-'0')
+	}
+	if check := isbn[9]; check == 'X' || check == 'x' {
+		sum += 10
+	} else {
+		sum += int(check - '0')
+	}
+	return sum%11 == 0
+}
+
+// stripISBNSeparators removes the hyphens or spaces between the groups of an ISBN, such as "978-616-08-1234-9".
+// Separators must all be the same, with no empty groups, and at most maxGroups groups.
+func stripISBNSeparators(isbn string, maxGroups int) (string, bool) {
+	separator := "-"
+	if strings.Contains(isbn, " ") {
+		separator = " "
+	}
+	groups := strings.Split(isbn, separator)
+	if len(groups) > maxGroups {
+		return "", false
+	}
+	for _, group := range groups {
+		if group == "" {
+			return "", false
+		}
+	}
+	return strings.Join(groups, ""), true
+}
+
+// validateISBN10 validates ISBN-10 numbers with their mod 11 check character, such as "0-306-40615-2" or
+// "080442957X". Groups may be separated by hyphens or spaces. It replaces go-playground/validator's isbn10
+// rule, which removes separators anywhere and rejects a lowercase x check character.
+// Usage:
+//   - `validate:"isbn10"` - e.g., "0306406152", "0-306-40615-2" or "0 8044 2957 X"
+func validateISBN10(fl validator.FieldLevel) bool {
+	field := fl.Field()
+	if field.Kind() != reflect.String {
+		return false
+	}
+
+	isbn, ok := stripISBNSeparators(field.String(), 4)
+	if !ok || len(isbn) != 10 || !isASCIIDigits(isbn[:9]) {
+		return false
+	}
+	if check := isbn[9]; !(check >= '0' && check <= '9') && check != 'X' && check != 'x' {
+		return false
+	}
+	return isbn10CheckValid(isbn)
+}
+
+// validateISBN13 validates ISBN-13 numbers: a 978 or 979 prefix and a GS1 check digit, such as
+// "978-0-306-40615-7". Groups may be separated by hyphens or spaces. It replaces go-playground/validator's
+// isbn13 rule, which removes separators anywhere.
+// Usage:
+//   - `validate:"isbn13"` - e.g., "9780306406157" or "978-616-08-1234-9"
+func validateISBN13(fl validator.FieldLevel) bool {
+	field := fl.Field()
+	if field.Kind() != reflect.String {
+		return false
+	}
+
+	isbn, ok := stripISBNSeparators(field.String(), 5)
+	if !ok || len(isbn) != 13 || !isASCIIDigits(isbn) {
+		return false
+	}
+	return (strings.HasPrefix(isbn, "978") || strings.HasPrefix(isbn, "979")) && gs1CheckValid(isbn)
+}
+
+// validateISBN validates ISBN-10 or ISBN-13 numbers, as accepted by the isbn10 and isbn13 rules.
+// It replaces go-playground/validator's isbn rule.
+// Usage:
+//   - `validate:"isbn"` - e.g., "0-306-40615-2" or "978-0-306-40615-7"
+func validateISBN(fl validator.FieldLevel) bool {
+	return validateISBN10(fl) || validateISBN13(fl)
+}
+
+// validateGS1Digits returns a validator for barcode numbers of exactly length digits with a valid GS1 check
+// digit. Separators are not allowed, and integer fields are invalid because leading zeros matter.
+// Usage:
+//   - `validate:"ean13"` - EAN-13, e.g., "8851234567898"
+//   - `validate:"upc"` - UPC-A, e.g., "036000291452"
+func validateGS1Digits(length int) validator.Func {
+	return func(fl validator.FieldLevel) bool {
+		field := fl.Field()
+		if field.Kind() != reflect.String {
+			return false
+		}
+
+		digits := field.String()
+		return len(digits) == length && isASCIIDigits(digits) && gs1CheckValid(digits)
+	}
+}
+
+// gtinLengths are the lengths of GTIN-8, GTIN-12 (UPC-A), GTIN-13 (EAN-13) and GTIN-14 numbers.
+var gtinLengths = map[int]bool{8: true, 12: true, 13: true, 14: true}
+
+// validateGTIN validates Global Trade Item Numbers: GTIN-8, GTIN-12, GTIN-13 or GTIN-14 digit strings with a
+// GS1 check digit. The parameter restricts the accepted lengths. As with ean13 and upc, separators are not
+// allowed and integer fields are invalid.
+// Usage:
+//   - `validate:"gtin"` - e.g., "96385074", "036000291452", "8851234567898" or "10614141000415"
+//   - `validate:"gtin=14"` - case-level GTIN-14 only
+//   - `validate:"gtin=12 13"` - UPC-A or EAN-13
+func validateGTIN(fl validator.FieldLevel) bool {
+	allowed := gtinLengths
+	if param := fl.Param(); param != "" {
+		allowed = make(map[int]bool)
+		for _, lengthText := range strings.Fields(param) {
+			length, err := strconv.Atoi(lengthText)
+			if err != nil || !gtinLengths[length] {
+				panicConfigError(fl, "expected GTIN lengths 8, 12, 13 or 14")
+			}
+			allowed[length] = true
+		}
+	}
+
+	field := fl.Field()
+	if field.Kind() != reflect.String {
+		return false
+	}
+
+	digits := field.String()
+	return allowed[len(digits)] && isASCIIDigits(digits) && gs1CheckValid(digits)
+}
+
+// Vehicle identification number validation logic functions
+
+// vinWeights are the position weights of the VIN check digit calculation (49 CFR 565.15); the check digit
+// itself, at position 9, has weight 0.
+var vinWeights = [17]int{8, 7, 6, 5, 4, 3, 2, 10, 0, 9, 8, 7, 6, 5, 4, 3, 2}
+
+// vinValue returns the transliterated value of a VIN character; I, O and Q are not allowed in VINs.
+func vinValue(c byte) (int, bool) {
+	switch {
+	case c >= '0' && c <= '9':
+		return int(c - '0'), true
+	case c >= 'A' && c <= 'H':
+		return int(c-'A') + 1, true
+	case c >= 'J' && c <= 'N':
+		return int(c-'J') + 1, true
+	case c == 'P':
+		return 7, true
+	case c == 'R':
+		return 9, true
+	case c >= 'S' && c <= 'Z':
+		return int(c-'S') + 2, true
+	default:
+		return 0, false
+	}
+}
+
+// isVIN reports whether vin is 17 uppercase letters and digits other than I, O and Q. With checkDigit, the
+// character at position 9 must be the check digit: the weighted sum of the transliterated characters modulo
+// 11, with 10 written as X.
+func isVIN(vin string, checkDigit bool) bool {
+	if len(vin) != 17 {
+		return false
+	}
+
+	sum := 0
+	for i := 0; i < len(vin); i++ {
+		value, ok := vinValue(vin[i])
+		if !ok {
+			return false
+		}
+		sum += value * vinWeights[i]
+	}
+	if !checkDigit {
+		return true
+	}
+
+	expected := byte('0' + sum%11)
+	if sum%11 == 10 {
+		expected = 'X'
+	}
+	return vin[8] == expected
+}
+
+// validateVIN validates 17-character vehicle identification numbers (ISO 3779) in uppercase, without I, O or Q,
+// and verifies the check digit at position 9. The check digit is required for vehicles sold in North America;
+// the "nocheck" parameter skips it for VINs from other markets, where position 9 may hold any character.
+// Usage:
+//   - `validate:"vin"` - e.g., "1HGCM82633A004352" or "1M8GDM9AXKP042788"
+//   - `validate:"vin=nocheck"` - e.g., "WVWZZZ1JZXW000001"
+func validateVIN(fl validator.FieldLevel) bool {
+	checkDigit := true
+	switch fl.Param() {
+	case "":
+	case "nocheck":
+		checkDigit = false
+	default:
+		panicConfigError(fl, "expected no parameter or nocheck")
+	}
+
+	field := fl.Field()
+	if field.Kind() != reflect.String {
+		return false
+	}
+	return isVIN(field.String(), checkDigit)
+}
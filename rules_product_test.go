@@ -0,0 +1,169 @@
+package xvalidator
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateProductCodes(t *testing.T) {
+	v, err := NewValidator()
+	require.NoError(t, err)
+
+	tests := []struct {
+		name    string
+		value   any
+		tag     string
+		wantErr bool
+	}{
+		{"isbn10 digits", "0306406152", "isbn10", false},
+		{"isbn10 hyphens", "0-306-40615-2", "isbn10", false},
+		{"isbn10 spaces", "0 8044 2957 X", "isbn10", false},
+		{"isbn10 check X", "080442957X", "isbn10", false},
+		{"isbn10 lowercase x", "080442957x", "isbn10", false},
+		{"isbn10 bad check digit", "0306406153", "isbn10", true},
+		{"isbn10 X not last", "08044295X7", "isbn10", true},
+		{"isbn10 mixed separators", "0-306 40615-2", "isbn10", true},
+		{"isbn10 double hyphen", "0--306-40615-2", "isbn10", true},
+		{"isbn10 leading hyphen", "-0306406152", "isbn10", true},
+		{"isbn10 too many groups", "0-3-0-64061-52", "isbn10", true},
+		{"isbn10 too short", "030640615", "isbn10", true},
+		{"isbn13 digits", "9780306406157", "isbn13", false},
+		{"isbn13 hyphens", "978-616-08-1234-9", "isbn13", false},
+		{"isbn13 979 prefix", "979-10-694-0106-8", "isbn13", false},
+		{"isbn13 bad check digit", "9780306406158", "isbn13", true},
+		{"isbn13 non-bookland prefix", "8851234567898", "isbn13", true},
+		{"isbn13 trailing space", "9780306406157 ", "isbn13", true},
+		{"isbn13 too many groups", "978-0-3-06-40615-7", "isbn13", true},
+		{"isbn either isbn10", "0-306-40615-2", "isbn", false},
+		{"isbn either isbn13", "978-0-306-40615-7", "isbn", false},
+		{"isbn neither", "978-0-306-40615-2", "isbn", true},
+		{"ean13", "8851234567898", "ean13", false},
+		{"ean13 isbn", "9780306406157", "ean13", false},
+		{"ean13 bad check digit", "8851234567892", "ean13", true},
+		{"ean13 too short", "885123456789", "ean13", true},
+		{"ean13 hyphens", "885-1234-567898", "ean13", true},
+		{"ean13 integer", 8851234567898, "ean13", true},
+		{"upc", "036000291452", "upc", false},
+		{"upc leading zeros", "000123456005", "upc", false},
+		{"upc bad check digit", "036000291453", "upc", true},
+		{"upc ean13", "8851234567898", "upc", true},
+		{"upc letters", "03600029145A", "upc", true},
+		{"empty", "", "upc", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := v.Var(tt.value, tt.tag)
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestGS1CheckValid(t *testing.T) {
+	for _, digits := range []string{"96385074", "9780306406157", "036000291452", "10614141000415"} {
+		assert.True(t, gs1CheckValid(digits), digits)
+	}
+	for _, digits := range []string{"96385075", "9780306406150", "036000291450"} {
+		assert.False(t, gs1CheckValid(digits), digits)
+	}
+}
+
+func TestValidateGTIN(t *testing.T) {
+	v, err := NewValidator()
+	require.NoError(t, err)
+
+	tests := []struct {
+		name    string
+		value   any
+		tag     string
+		wantErr bool
+	}{
+		{"gtin-8", "96385074", "gtin", false},
+		{"gtin-12", "036000291452", "gtin", false},
+		{"gtin-13", "8851234567898", "gtin", false},
+		{"gtin-14", "10614141000415", "gtin", false},
+		{"allowed length", "10614141000415", "gtin=14", false},
+		{"allowed lengths", "036000291452", "gtin=12 13", false},
+		{"bad check digit", "10614141000416", "gtin", true},
+		{"unsupported length", "0614141000415", "gtin=14", true},
+		{"length outside list", "96385074", "gtin=12 13", true},
+		{"gtin-10 length", "0306406152", "gtin", true},
+		{"separators", "0 36000 29145 2", "gtin", true},
+		{"integer", 96385074, "gtin", true},
+		{"empty", "", "gtin", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := v.Var(tt.value, tt.tag)
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+
+	for _, param := range []string{"10", "ean", "13;14"} {
+		t.Run("invalid length "+param+" is a config error", func(t *testing.T) {
+			err := v.Var("8851234567898", "gtin="+param)
+
+			var configErr *ConfigError
+			require.ErrorAs(t, err, &configErr)
+			assert.Equal(t, "gtin", configErr.Tag)
+		})
+	}
+}
+
+func TestValidateVIN(t *testing.T) {
+	v, err := NewValidator()
+	require.NoError(t, err)
+
+	tests := []struct {
+		name    string
+		value   any
+		tag     string
+		wantErr bool
+	}{
+		{"valid", "1HGCM82633A004352", "vin", false},
+		{"check digit X", "1M8GDM9AXKP042788", "vin", false},
+		{"all ones", "11111111111111111", "vin", false},
+		{"bad check digit", "1HGCM82643A004352", "vin", true},
+		{"european without check digit", "WVWZZZ1JZXW000001", "vin", true},
+		{"european with nocheck", "WVWZZZ1JZXW000001", "vin=nocheck", false},
+		{"letter O", "1HGCM82633AO04352", "vin=nocheck", true},
+		{"letter I", "1HGCM8263IA004352", "vin=nocheck", true},
+		{"letter Q", "QHGCM82633A004352", "vin=nocheck", true},
+		{"lowercase", "1hgcm82633a004352", "vin", true},
+		{"too short", "1HGCM82633A00435", "vin", true},
+		{"too long", "1HGCM82633A0043521", "vin", true},
+		{"hyphen", "1HGCM8263-A004352", "vin=nocheck", true},
+		{"empty", "", "vin", true},
+		{"non-string", 11111111111111111, "vin", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := v.Var(tt.value, tt.tag)
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+
+	t.Run("invalid param is a config error", func(t *testing.T) {
+		err := v.Var("1HGCM82633A004352", "vin=eu")
+
+		var configErr *ConfigError
+		require.ErrorAs(t, err, &configErr)
+		assert.Equal(t, "vin", configErr.Tag)
+	})
+}
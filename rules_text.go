@@ -0,0 +1,203 @@
+package xvalidator
+
+import (
+	"reflect"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/go-playground/validator/v10"
+	"golang.org/x/net/html"
+)
+
+// characterClassNames names characters commonly denied in injection-safe fields, for error messages.
+var characterClassNames = map[rune]string{
+	'<':  "angle brackets",
+	'>':  "angle brackets",
+	'"':  "quotes",
+	'\'': "quotes",
+	'`':  "backticks",
+	'\\': "backslashes",
+	'/':  "slashes",
+	';':  "semicolons",
+	'&':  "ampersands",
+	'$':  "dollar signs",
+	'%':  "percent signs",
+	'{':  "braces",
+	'}':  "braces",
+	'(':  "parentheses",
+	')':  "parentheses",
+	'|':  "pipes",
+	'\n': "line breaks",
+	'\r': "line breaks",
+	'\t': "tabs",
+}
+
+// characterClassName describes the class of r for messages, such as "angle brackets" for '<',
+// falling back to the quoted character.
+func characterClassName(r rune) string {
+	if name, ok := characterClassNames[r]; ok {
+		return name
+	}
+	return strconv.QuoteRune(r)
+}
+
+// firstExcludedChar returns the first character of value listed in chars.
+func firstExcludedChar(value, chars string) (rune, bool) {
+	if i := strings.IndexAny(value, chars); i >= 0 {
+		for _, r := range value[i:] {
+			return r, true
+		}
+	}
+	return 0, false
+}
+
+// validateExcludesChars validates that a string contains none of the characters listed in the parameter.
+// Unlike excludesall, its translated message names the class of the offending character (e.g., "angle brackets").
+// Inside struct tags write "," as 0x2C, "|" as 0x7C and escape quotes and backslashes.
+// Usage:
+//   - `validate:"excludes_chars=<>\"'"` - no HTML or quote characters
+//   - `validate:"excludes_chars=;&0x7C$"` - no shell metacharacters
+func validateExcludesChars(fl validator.FieldLevel) bool {
+	if fl.Param() == "" {
+		panicConfigError(fl, "expected the characters to exclude")
+	}
+
+	field := fl.Field()
+	if field.Kind() != reflect.String {
+		return false
+	}
+	_, found := firstExcludedChar(field.String(), fl.Param())
+	return !found
+}
+
+// emojiTable holds the code points displayed as emoji by default: the pictographic blocks of the supplementary
+// planes, regional indicators (flags), skin tone modifiers and the BMP symbols with Emoji_Presentation.
+// Symbols that default to text, such as "©" or "★", count only when followed by the emoji variation selector.
+var emojiTable = &unicode.RangeTable{
+	R16: []unicode.Range16{
+		{Lo: 0x231a, Hi: 0x231b, Stride: 1},
+		{Lo: 0x23e9, Hi: 0x23ec, Stride: 1},
+		{Lo: 0x23f0, Hi: 0x23f3, Stride: 3},
+		{Lo: 0x25fd, Hi: 0x25fe, Stride: 1},
+		{Lo: 0x2614, Hi: 0x2615, Stride: 1},
+		{Lo: 0x2648, Hi: 0x2653, Stride: 1},
+		{Lo: 0x267f, Hi: 0x2693, Stride: 20},
+		{Lo: 0x26a1, Hi: 0x26a1, Stride: 1},
+		{Lo: 0x26aa, Hi: 0x26ab, Stride: 1},
+		{Lo: 0x26bd, Hi: 0x26be, Stride: 1},
+		{Lo: 0x26c4, Hi: 0x26c5, Stride: 1},
+		{Lo: 0x26ce, Hi: 0x26d4, Stride: 6},
+		{Lo: 0x26ea, Hi: 0x26ea, Stride: 1},
+		{Lo: 0x26f2, Hi: 0x26f3, Stride: 1},
+		{Lo: 0x26f5, Hi: 0x26fa, Stride: 5},
+		{Lo: 0x26fd, Hi: 0x2705, Stride: 8},
+		{Lo: 0x270a, Hi: 0x270b, Stride: 1},
+		{Lo: 0x2728, Hi: 0x274c, Stride: 36},
+		{Lo: 0x274e, Hi: 0x274e, Stride: 1},
+		{Lo: 0x2753, Hi: 0x2755, Stride: 1},
+		{Lo: 0x2757, Hi: 0x2757, Stride: 1},
+		{Lo: 0x2795, Hi: 0x2797, Stride: 1},
+		{Lo: 0x27b0, Hi: 0x27bf, Stride: 15},
+		{Lo: 0x2b1b, Hi: 0x2b1c, Stride: 1},
+		{Lo: 0x2b50, Hi: 0x2b55, Stride: 5},
+	},
+	R32: []unicode.Range32{
+		{Lo: 0x1f004, Hi: 0x1f004, Stride: 1},
+		{Lo: 0x1f0cf, Hi: 0x1f0cf, Stride: 1},
+		{Lo: 0x1f18e, Hi: 0x1f18e, Stride: 1},
+		{Lo: 0x1f191, Hi: 0x1f19a, Stride: 1},
+		{Lo: 0x1f1e6, Hi: 0x1f1ff, Stride: 1},
+		{Lo: 0x1f201, Hi: 0x1f202, Stride: 1},
+		{Lo: 0x1f21a, Hi: 0x1f22f, Stride: 21},
+		{Lo: 0x1f232, Hi: 0x1f23a, Stride: 1},
+		{Lo: 0x1f250, Hi: 0x1f251, Stride: 1},
+		{Lo: 0x1f300, Hi: 0x1f64f, Stride: 1},
+		{Lo: 0x1f680, Hi: 0x1f6ff, Stride: 1},
+		{Lo: 0x1f7e0, Hi: 0x1f7eb, Stride: 1},
+		{Lo: 0x1f7f0, Hi: 0x1f7f0, Stride: 1},
+		{Lo: 0x1f900, Hi: 0x1f9ff, Stride: 1},
+		{Lo: 0x1fa70, Hi: 0x1faff, Stride: 1},
+		{Lo: 0xe0020, Hi: 0xe007f, Stride: 1},
+	},
+}
+
+// emojiVariationSelector requests emoji presentation of the preceding character (VS16).
+const emojiVariationSelector = '\ufe0f'
+
+// combiningKeycap turns a preceding digit, "#" or "*" into a keycap emoji.
+const combiningKeycap = '\u20e3'
+
+// containsEmoji reports whether s contains an emoji: a code point displayed as emoji by default, or a
+// character followed by the emoji variation selector or the combining keycap.
+func containsEmoji(s string) bool {
+	return strings.IndexFunc(s, func(r rune) bool {
+		return r == emojiVariationSelector || r == combiningKeycap || unicode.Is(emojiTable, r)
+	}) >= 0
+}
+
+// validateNoEmoji validates that a string contains no emoji or pictographs, including flags, keycaps and
+// skin tone or ZWJ sequences, for names and descriptions passed to systems that cannot store or print them.
+// Text symbols such as "©", "™" and "★" are allowed unless written with emoji presentation.
+// Usage:
+//   - `validate:"no_emoji"` - e.g., "Jane Doe" but not "Jane 😀"
+func validateNoEmoji(fl validator.FieldLevel) bool {
+	field := fl.Field()
+	if field.Kind() != reflect.String {
+		return false
+	}
+	return !containsEmoji(field.String())
+}
+
+// validateCleanText returns a validator rejecting strings that contain banned words reported by the WordList,
+// for user-generated display names and reviews. The list is the embedded DefaultBannedWords unless replaced
+// with WithWordList.
+// Usage:
+//   - `validate:"clean_text"` - e.g., display names and review text
+func validateCleanText(list func() WordList) validator.Func {
+	return func(fl validator.FieldLevel) bool {
+		field := fl.Field()
+		if field.Kind() != reflect.String {
+			return false
+		}
+		return !list().ContainsBannedWord(field.String())
+	}
+}
+
+// containsHTML reports whether s holds HTML markup, as recognized by the HTML5 tokenizer: tags, comments,
+// doctypes, processing instructions, or a tag left unterminated at the end of s, which browsers may still
+// complete. Stray "<" and ">" in text, such as "a < b", are not markup. With decodeEntities, text that
+// only becomes markup once its character references are decoded ("&lt;b&gt;") counts as HTML too.
+func containsHTML(s string, decodeEntities bool) bool {
+	tokenizer := html.NewTokenizer(strings.NewReader(s))
+	consumed := 0
+	for {
+		switch tokenizer.Next() {
+		case html.ErrorToken:
+			// The tokenizer drops a tag cut off by the end of the input
+			return consumed < len(s)
+		case html.TextToken:
+			raw := tokenizer.Raw()
+			consumed += len(raw)
+			if decodeEntities && strings.IndexByte(string(raw), '&') >= 0 &&
+				containsHTML(html.UnescapeString(string(raw)), false) {
+				return true
+			}
+		default:
+			return true
+		}
+	}
+}
+
+// validateNoHTML validates that a string is plain text without HTML tags, comments or script content, using
+// the HTML5 tokenizer rather than rejecting every "<". Unterminated tags such as "<img src=x onerror=..." and
+// entity-encoded tags such as "&lt;script&gt;" are rejected too.
+// Usage:
+//   - `validate:"no_html"` - e.g., "Fits 2 < 3 people" but not "<b>Sale</b>"
+func validateNoHTML(fl validator.FieldLevel) bool {
+	field := fl.Field()
+	if field.Kind() != reflect.String {
+		return false
+	}
+	return !containsHTML(field.String(), true)
+}
@@ -0,0 +1,197 @@
+package xvalidator
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateExcludesChars(t *testing.T) {
+	v, err := NewValidator()
+	require.NoError(t, err)
+
+	tests := []struct {
+		name    string
+		value   string
+		tag     string
+		wantErr bool
+	}{
+		{"clean text", "Jane O Doe", "excludes_chars=<>\"'", false},
+		{"empty", "", "excludes_chars=<>", false},
+		{"non-ASCII text", "สมชาย ใจดี", "excludes_chars=<>", false},
+		{"angle bracket", "<b>Jane</b>", "excludes_chars=<>\"'", true},
+		{"quote", "O'Brien", "excludes_chars=<>\"'", true},
+		{"encoded pipe", "a|b", "excludes_chars=;&0x7C", true},
+		{"encoded comma", "1,000", "excludes_chars=0x2C", true},
+		{"non-ASCII character", "price ฿50", "excludes_chars=฿$", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := v.Var(tt.value, tt.tag)
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+
+	t.Run("struct tag with escaped characters", func(t *testing.T) {
+		type Comment struct {
+			Author string `json:"author" validate:"excludes_chars=<>\"'\\"`
+		}
+
+		assert.NoError(t, v.Struct(Comment{Author: "Jane"}))
+
+		err := v.StructTranslated(Comment{Author: `C:\Users`})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "author must not contain backslashes")
+
+		err = v.StructTranslated(Comment{Author: `"Jane"`})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "author must not contain quotes")
+	})
+
+	t.Run("missing parameter is a config error", func(t *testing.T) {
+		err := v.Var("Jane", "excludes_chars")
+		var configErr *ConfigError
+		require.ErrorAs(t, err, &configErr)
+		assert.Equal(t, "excludes_chars", configErr.Tag)
+	})
+}
+
+func TestValidateNoEmoji(t *testing.T) {
+	v, err := NewValidator()
+	require.NoError(t, err)
+
+	tests := []struct {
+		name    string
+		value   string
+		wantErr bool
+	}{
+		{"plain text", "Jane Doe", false},
+		{"thai text", "ค่าบริการรายเดือน", false},
+		{"text symbols", "Acme™ © 2026 ★ ✓ → €5", false},
+		{"empty", "", false},
+		{"face", "Jane 😀", true},
+		{"emoji with presentation by default", "Done ✅", true},
+		{"text symbol with emoji presentation", "I ❤️ Go", true},
+		{"flag", "Made in 🇹🇭", true},
+		{"keycap", "Press 1️⃣", true},
+		{"skin tone", "👍🏽", true},
+		{"zwj sequence", "👩‍💻", true},
+		{"newer emoji", "🫠", true},
+		{"watch", "⌚ deals", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := v.Var(tt.value, "no_emoji")
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+// moderationStub is a WordList backed by a function, standing in for a moderation service.
+type moderationStub func(text string) bool
+
+func (m moderationStub) ContainsBannedWord(text string) bool {
+	return m(text)
+}
+
+func TestValidateCleanText(t *testing.T) {
+	v, err := NewValidator()
+	require.NoError(t, err)
+
+	tests := []struct {
+		name    string
+		value   string
+		wantErr bool
+	}{
+		{"clean review", "Great service, would book again!", false},
+		{"embedded word is not a match", "Scunthorpe class assessment, Dickens", false},
+		{"thai review", "บริการดีมาก ประทับใจ", false},
+		{"empty", "", false},
+		{"banned word", "This is shit.", true},
+		{"banned word in uppercase", "WHAT THE FUCK", true},
+		{"banned word between punctuation", "total_bullshit!!", true},
+		{"banned phrase", "you son-of-a-bitch", true},
+		{"thai banned word", "ร้านนี้เหี้ยมาก", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := v.Var(tt.value, "clean_text")
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+
+	t.Run("extended word list", func(t *testing.T) {
+		custom, err := NewValidator(WithWordList(NewWordList(append(DefaultBannedWords(), "spam link"))))
+		require.NoError(t, err)
+
+		assert.Error(t, custom.Var("click this SPAM  link", "clean_text"))
+		assert.Error(t, custom.Var("shit", "clean_text"))
+		assert.NoError(t, custom.Var("spam", "clean_text"))
+	})
+
+	t.Run("custom provider", func(t *testing.T) {
+		custom, err := NewValidator(WithWordList(moderationStub(func(text string) bool {
+			return strings.Contains(text, "forbidden")
+		})))
+		require.NoError(t, err)
+
+		assert.Error(t, custom.Var("a forbidden review", "clean_text"))
+		assert.NoError(t, custom.Var("This is shit.", "clean_text"))
+	})
+}
+
+func TestValidateNoHTML(t *testing.T) {
+	v, err := NewValidator()
+	require.NoError(t, err)
+
+	tests := []struct {
+		name    string
+		value   string
+		wantErr bool
+	}{
+		{"plain text", "Handmade ceramic mug, 350 ml", false},
+		{"comparison", "Fits 2 < 3 people and 4 > 2", false},
+		{"heart", "a <3 you", false},
+		{"empty angle brackets", "<>", false},
+		{"escaped text entity", "Tom &amp; Jerry", false},
+		{"encoded heart", "&lt;3", false},
+		{"empty", "", false},
+		{"bold tag", "<b>Sale</b>", true},
+		{"script", "<script>alert(1)</script>", true},
+		{"end tag only", "text</div>", true},
+		{"comment", "hi <!-- hidden -->", true},
+		{"doctype", "<!DOCTYPE html>", true},
+		{"processing instruction", "<?xml version=\"1.0\"?>", true},
+		{"unterminated tag", "<img src=x onerror=alert(1)", true},
+		{"tag split across lines", "<a\nhref=\"https://example.com\">link</a>", true},
+		{"entity-encoded tag", "&lt;script&gt;alert(1)&lt;/script&gt;", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := v.Var(tt.value, "no_html")
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
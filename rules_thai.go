@@ -0,0 +1,338 @@
+package xvalidator
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/go-playground/validator/v10"
+)
+
+// Thai validation logic functions
+
+// thaiIDChecksumValid verifies the MOD 11 check digit shared by 13-digit Thai national ID and tax ID numbers.
+// The 13th digit equals (11 - Σ dᵢ·(13-i) mod 11) mod 10 over the first 12 digits,
+// so a remainder of 0 yields check digit 1 and a remainder of 1 yields 0.
+func thaiIDChecksumValid(s string) bool {
+	sum := weightedDigitSum(s, []int{13, 12, 11, 10, 9, 8, 7, 6, 5, 4, 3, 2})
+	return (11-sum%11)%10 == int(s[12]-'0')
+}
+
+// isThaiIDNumber reports whether s is a 13-digit number with a valid Thai MOD 11 check digit.
+func isThaiIDNumber(s string) bool {
+	if len(s) != 13 {
+		return false
+	}
+	for i := 0; i < len(s); i++ {
+		if s[i] < '0' || s[i] > '9' {
+			return false
+		}
+	}
+	return thaiIDChecksumValid(s)
+}
+
+// validateThaiID validates Thai national ID card numbers (เลขประจำตัวประชาชน).
+// The value must be 13 digits without separators, the last digit being the MOD 11 check digit.
+// Usage: `validate:"thai_id"`
+func validateThaiID(fl validator.FieldLevel) bool {
+	field := fl.Field()
+	if field.Kind() != reflect.String {
+		return false
+	}
+
+	return isThaiIDNumber(field.String())
+}
+
+// validateThaiTaxID validates Thai taxpayer identification numbers (เลขประจำตัวผู้เสียภาษีอากร).
+// Individuals use their 13-digit national ID; juristic persons (companies, partnerships, foundations)
+// use a 13-digit number starting with 0. Both carry the MOD 11 check digit.
+// Usage:
+//   - `validate:"thai_tax_id"` - individual or juristic person
+//   - `validate:"thai_tax_id=individual"` - individual only (first digit 1-8)
+//   - `validate:"thai_tax_id=juristic"` - juristic person only (first digit 0)
+func validateThaiTaxID(fl validator.FieldLevel) bool {
+	var allowIndividual, allowJuristic bool
+	switch fl.Param() {
+	case "":
+		allowIndividual, allowJuristic = true, true
+	case "individual":
+		allowIndividual = true
+	case "juristic":
+		allowJuristic = true
+	default:
+		panicConfigError(fl, "expected no parameter, 'individual' or 'juristic'")
+	}
+
+	field := fl.Field()
+	if field.Kind() != reflect.String {
+		return false
+	}
+
+	taxID := field.String()
+	if !isThaiIDNumber(taxID) {
+		return false
+	}
+
+	switch {
+	case taxID[0] == '0':
+		return allowJuristic
+	case taxID[0] <= '8':
+		return allowIndividual
+	default:
+		return false
+	}
+}
+
+// validateThaiBankCode validates 3-digit Bank of Thailand bank codes (e.g., "004" for Kasikornbank).
+// Usage: `validate:"thai_bank_code"`
+func validateThaiBankCode(fl validator.FieldLevel) bool {
+	field := fl.Field()
+	if field.Kind() != reflect.String {
+		return false
+	}
+
+	_, ok := thaiBanks[field.String()]
+	return ok
+}
+
+// thaiBankAccountDigits returns the number of digits in a bank account number, which may be grouped with
+// hyphens (e.g., "123-4-56789-0"). Returns false when other characters or misplaced hyphens are present.
+func thaiBankAccountDigits(account string) (int, bool) {
+	digits := 0
+	for i := 0; i < len(account); i++ {
+		switch c := account[i]; {
+		case c >= '0' && c <= '9':
+			digits++
+		case c == '-' && i > 0 && i < len(account)-1 && account[i-1] != '-':
+		default:
+			return 0, false
+		}
+	}
+	return digits, digits > 0
+}
+
+// validateThaiBankAccount validates Thai bank account numbers, optionally grouped with hyphens.
+// With a bank code field parameter, the length must match that bank's account format;
+// without it, or when the bank publishes no single length, 10 to 12 digits are accepted.
+// Usage:
+//   - `validate:"thai_bank_account=BankCode"` - length based on the sibling BankCode field
+//   - `validate:"thai_bank_account"` - 10 to 12 digits
+func validateThaiBankAccount(fl validator.FieldLevel) bool {
+	var lengths []int
+	if param := fl.Param(); param != "" {
+		codeField, found := lookupFieldPath(fl.Parent(), param)
+		if !found {
+			panicConfigError(fl, "bank code references a field that does not exist")
+		}
+		if !codeField.IsValid() || codeField.Kind() != reflect.String {
+			return false
+		}
+
+		bank, ok := thaiBanks[codeField.String()]
+		if !ok {
+			return false
+		}
+		lengths = bank.accountLengths
+	}
+
+	field := fl.Field()
+	if field.Kind() != reflect.String {
+		return false
+	}
+
+	digits, ok := thaiBankAccountDigits(field.String())
+	if !ok {
+		return false
+	}
+
+	if lengths == nil {
+		return digits >= 10 && digits <= 12
+	}
+	for _, length := range lengths {
+		if digits == length {
+			return true
+		}
+	}
+	return false
+}
+
+// validateThaiPlate validates Thai vehicle license plates such as "กข 1234", "1กข 1234" or
+// "1กข 1234 กรุงเทพมหานคร". A trailing province name must be one of the Thai provinces.
+// Usage:
+//   - `validate:"thai_plate"` - province optional
+//   - `validate:"thai_plate=province"` - province required
+func validateThaiPlate(fl validator.FieldLevel) bool {
+	var requireProvince bool
+	switch fl.Param() {
+	case "":
+	case "province":
+		requireProvince = true
+	default:
+		panicConfigError(fl, "expected no parameter or 'province'")
+	}
+
+	field := fl.Field()
+	if field.Kind() != reflect.String {
+		return false
+	}
+
+	match := ThaiPlateRegex().FindStringSubmatch(field.String())
+	if match == nil {
+		return false
+	}
+
+	province := match[1]
+	if province == "" {
+		return !requireProvince
+	}
+	_, ok := thaiProvinces[province]
+	return ok
+}
+
+// isThaiRune reports whether r is an assigned character of the Thai Unicode block
+// (consonants, vowels, tone marks, Thai digits and symbols: U+0E01-U+0E3A and U+0E3F-U+0E5B).
+func isThaiRune(r rune) bool {
+	return (r >= 0x0E01 && r <= 0x0E3A) || (r >= 0x0E3F && r <= 0x0E5B)
+}
+
+// validateThaiTextRule creates a validator accepting strings of Thai characters, extended by the
+// space-separated options in the tag parameter: "spaces" allows ASCII spaces and "digits" allows ASCII digits.
+// allowSpaces sets whether spaces are accepted without the option. At least one Thai character is required.
+func validateThaiTextRule(allowSpaces bool) validator.Func {
+	return func(fl validator.FieldLevel) bool {
+		spaces, digits := allowSpaces, false
+		for _, option := range strings.Fields(fl.Param()) {
+			switch option {
+			case "spaces":
+				spaces = true
+			case "digits":
+				digits = true
+			default:
+				panicConfigError(fl, "unknown option '"+option+"', expected 'spaces' or 'digits'")
+			}
+		}
+
+		field := fl.Field()
+		if field.Kind() != reflect.String {
+			return false
+		}
+
+		hasThai := false
+		for _, r := range field.String() {
+			switch {
+			case isThaiRune(r):
+				hasThai = true
+			case r == ' ' && spaces:
+			case r >= '0' && r <= '9' && digits:
+			default:
+				return false
+			}
+		}
+		return hasThai
+	}
+}
+
+// buddhistEraOffset is the difference between Buddhist Era (BE) and Common Era (CE) years.
+const buddhistEraOffset = 543
+
+// ParseBuddhistEraDate parses a date whose year is written in the Thai Buddhist Era (CE + 543),
+// such as "2569-10-16" with layout "2006-01-02", and returns it in the Common Era.
+// The layout must contain the four-digit year element "2006". The year is converted before parsing,
+// so leap days are checked against the Common Era year.
+func ParseBuddhistEraDate(layout, value string) (time.Time, error) {
+	yearIndex := strings.Index(layout, "2006")
+	if yearIndex < 0 {
+		return time.Time{}, fmt.Errorf("xvalidator: layout %q has no four-digit year element 2006", layout)
+	}
+
+	// parseWithYearAt converts the four-digit year at value[i:i+4] to the Common Era and parses the result.
+	parseWithYearAt := func(i int) (time.Time, bool) {
+		year := digitsValue(value[i:i+4]) - buddhistEraOffset
+		if year < 1 {
+			return time.Time{}, false
+		}
+		t, err := time.Parse(layout, value[:i]+fmt.Sprintf("%04d", year)+value[i+4:])
+		return t, err == nil
+	}
+
+	// Most layouts place only fixed-width elements before the year, so it sits at the same offset in the value
+	if yearIndex+4 <= len(value) && isASCIIDigits(value[yearIndex:yearIndex+4]) {
+		if t, ok := parseWithYearAt(yearIndex); ok {
+			return t, nil
+		}
+	}
+
+	// Otherwise try each standalone run of four digits
+	for i := 0; i+4 <= len(value); i++ {
+		if i != yearIndex && isStandaloneDigitRun(value, i, 4) {
+			if t, ok := parseWithYearAt(i); ok {
+				return t, nil
+			}
+		}
+	}
+
+	return time.Time{}, fmt.Errorf("xvalidator: cannot parse %q as a Buddhist Era date with layout %q", value, layout)
+}
+
+// isStandaloneDigitRun reports whether s[i:i+n] consists of digits not adjacent to other digits.
+func isStandaloneDigitRun(s string, i, n int) bool {
+	if !isASCIIDigits(s[i : i+n]) {
+		return false
+	}
+	before := i == 0 || s[i-1] < '0' || s[i-1] > '9'
+	after := i+n == len(s) || s[i+n] < '0' || s[i+n] > '9'
+	return before && after
+}
+
+// parseDateBEParam splits a date_be parameter into the Go layout (default "2006-01-02") and an optional
+// trailing ":future" or ":past" direction; a bare "future" or "past" uses the default layout.
+func parseDateBEParam(param string) (layout, direction string) {
+	layout = param
+	if param == "future" || param == "past" {
+		layout, direction = "", param
+	} else if i := strings.LastIndex(param, ":"); i >= 0 && (param[i+1:] == "future" || param[i+1:] == "past") {
+		layout, direction = param[:i], param[i+1:]
+	}
+
+	if layout == "" {
+		layout = "2006-01-02"
+	}
+	return layout, direction
+}
+
+// validateDateBE returns a validator for date strings written with a Thai Buddhist Era year, parsed with the
+// Go layout given as parameter (default "2006-01-02"). The date must exist in the Common Era calendar after
+// conversion. A trailing future or past option also requires the converted date to lie after or before the
+// current time according to now, like future_date and past_date.
+// Usage:
+//   - `validate:"date_be"` - e.g., "2569-10-16"
+//   - `validate:"date_be=02/01/2006"` - e.g., "16/10/2569"
+//   - `validate:"date_be=past"` - e.g., "2540-05-01" (1997-05-01)
+//   - `validate:"date_be=02/01/2006:future"` - e.g., "01/01/2570" when today is 2026-10-16
+func validateDateBE(now func() time.Time) validator.Func {
+	return func(fl validator.FieldLevel) bool {
+		layout, direction := parseDateBEParam(fl.Param())
+		if !strings.Contains(layout, "2006") {
+			panicConfigError(fl, "layout must contain the four-digit year element 2006")
+		}
+
+		field := fl.Field()
+		if field.Kind() != reflect.String {
+			return false
+		}
+
+		t, err := ParseBuddhistEraDate(layout, field.String())
+		if err != nil {
+			return false
+		}
+		switch direction {
+		case "future":
+			return t.After(now())
+		case "past":
+			return t.Before(now())
+		default:
+			return true
+		}
+	}
+}
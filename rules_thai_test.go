@@ -0,0 +1,413 @@
+package xvalidator
+
+import (
+	"testing"
+	"time"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateThaiID(t *testing.T) {
+	v := validator.New()
+	RegisterThaiValidators(v)
+
+	tests := []struct {
+		name    string
+		value   interface{}
+		wantErr bool
+	}{
+		{name: "valid id", value: "1103700166114", wantErr: false},
+		{name: "valid id with remainder zero", value: "1000000000131", wantErr: false},
+		{name: "remainder zero with check digit 0", value: "1000000000130", wantErr: true},
+		{name: "wrong check digit", value: "1103700166115", wantErr: true},
+		{name: "too short", value: "110370016611", wantErr: true},
+		{name: "too long", value: "11037001661140", wantErr: true},
+		{name: "formatted with hyphens", value: "1-1037-00166-11-4", wantErr: true},
+		{name: "non-digit", value: "11037001661a4", wantErr: true},
+		{name: "empty string", value: "", wantErr: true},
+		{name: "non-string value", value: 1103700166114, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := v.Var(tt.value, "thai_id")
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestValidateThaiTaxID(t *testing.T) {
+	v := validator.New()
+	RegisterThaiValidators(v)
+
+	tests := []struct {
+		name    string
+		value   interface{}
+		tag     string
+		wantErr bool
+	}{
+		{name: "juristic person", value: "0105536112014", tag: "thai_tax_id", wantErr: false},
+		{name: "government agency", value: "0994000165510", tag: "thai_tax_id", wantErr: false},
+		{name: "individual", value: "1103700166114", tag: "thai_tax_id", wantErr: false},
+		{name: "wrong check digit", value: "0105536112015", tag: "thai_tax_id", wantErr: true},
+		{name: "unassigned leading digit", value: "9000000000004", tag: "thai_tax_id", wantErr: true},
+		{name: "too short", value: "010553611201", tag: "thai_tax_id", wantErr: true},
+		{name: "non-string value", value: 105536112014, tag: "thai_tax_id", wantErr: true},
+		{name: "juristic only accepts juristic", value: "0105536112014", tag: "thai_tax_id=juristic", wantErr: false},
+		{name: "juristic only rejects individual", value: "1103700166114", tag: "thai_tax_id=juristic", wantErr: true},
+		{name: "individual only accepts individual", value: "1103700166114", tag: "thai_tax_id=individual", wantErr: false},
+		{name: "individual only rejects juristic", value: "0105536112014", tag: "thai_tax_id=individual", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := v.Var(tt.value, tt.tag)
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestValidateThaiTaxID_InvalidParam(t *testing.T) {
+	v, err := NewValidator()
+	require.NoError(t, err)
+
+	err = v.Var("0105536112014", "thai_tax_id=company")
+
+	var configErr *ConfigError
+	require.ErrorAs(t, err, &configErr)
+	assert.Equal(t, "thai_tax_id", configErr.Tag)
+}
+
+func TestValidateThaiBankCode(t *testing.T) {
+	v := validator.New()
+	RegisterThaiValidators(v)
+
+	tests := []struct {
+		name    string
+		value   interface{}
+		wantErr bool
+	}{
+		{name: "kasikornbank", value: "004", wantErr: false},
+		{name: "government savings bank", value: "030", wantErr: false},
+		{name: "unknown code", value: "999", wantErr: true},
+		{name: "missing leading zero", value: "4", wantErr: true},
+		{name: "bank abbreviation", value: "KBANK", wantErr: true},
+		{name: "empty string", value: "", wantErr: true},
+		{name: "non-string value", value: 4, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := v.Var(tt.value, "thai_bank_code")
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestValidateThaiBankAccount(t *testing.T) {
+	v := validator.New()
+	RegisterThaiValidators(v)
+
+	type transfer struct {
+		BankCode string
+		Account  string `validate:"thai_bank_account=BankCode"`
+	}
+
+	tests := []struct {
+		name    string
+		input   transfer
+		wantErr bool
+	}{
+		{name: "kasikornbank 10 digits", input: transfer{BankCode: "004", Account: "1234567890"}, wantErr: false},
+		{name: "kasikornbank grouped", input: transfer{BankCode: "004", Account: "123-4-56789-0"}, wantErr: false},
+		{name: "kasikornbank 12 digits", input: transfer{BankCode: "004", Account: "123456789012"}, wantErr: true},
+		{name: "government savings bank 12 digits", input: transfer{BankCode: "030", Account: "123456789012"}, wantErr: false},
+		{name: "government savings bank 10 digits", input: transfer{BankCode: "030", Account: "1234567890"}, wantErr: true},
+		{name: "bank without fixed length", input: transfer{BankCode: "017", Account: "12345678901"}, wantErr: false},
+		{name: "unknown bank code", input: transfer{BankCode: "999", Account: "1234567890"}, wantErr: true},
+		{name: "double hyphen", input: transfer{BankCode: "004", Account: "123--4567890"}, wantErr: true},
+		{name: "leading hyphen", input: transfer{BankCode: "004", Account: "-1234567890"}, wantErr: true},
+		{name: "spaces", input: transfer{BankCode: "004", Account: "123 4 56789 0"}, wantErr: true},
+		{name: "empty account", input: transfer{BankCode: "004", Account: ""}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := v.Struct(tt.input)
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestValidateThaiBankAccount_WithoutParam(t *testing.T) {
+	v := validator.New()
+	RegisterThaiValidators(v)
+
+	assert.NoError(t, v.Var("1234567890", "thai_bank_account"))
+	assert.NoError(t, v.Var("123456789012", "thai_bank_account"))
+	assert.Error(t, v.Var("123456789", "thai_bank_account"))
+	assert.Error(t, v.Var("1234567890123", "thai_bank_account"))
+	assert.Error(t, v.Var(1234567890, "thai_bank_account"))
+}
+
+func TestValidateThaiBankAccount_InvalidParam(t *testing.T) {
+	v, err := NewValidator()
+	require.NoError(t, err)
+
+	type transfer struct {
+		Account string `validate:"thai_bank_account=Bank"`
+	}
+
+	err = v.Struct(transfer{Account: "1234567890"})
+
+	var configErr *ConfigError
+	require.ErrorAs(t, err, &configErr)
+	assert.Equal(t, "thai_bank_account", configErr.Tag)
+	assert.Equal(t, "bank code references a field that does not exist", configErr.Reason)
+}
+
+func TestThaiBankName(t *testing.T) {
+	name, ok := ThaiBankName("014")
+	assert.True(t, ok)
+	assert.Equal(t, "Siam Commercial Bank", name)
+
+	_, ok = ThaiBankName("000")
+	assert.False(t, ok)
+}
+
+func TestValidateThaiPlate(t *testing.T) {
+	v := validator.New()
+	RegisterThaiValidators(v)
+
+	tests := []struct {
+		name    string
+		value   interface{}
+		tag     string
+		wantErr bool
+	}{
+		{name: "two consonants", value: "กข 1234", tag: "thai_plate", wantErr: false},
+		{name: "single consonant", value: "ก 1", tag: "thai_plate", wantErr: false},
+		{name: "digit prefix series", value: "1กข 1234", tag: "thai_plate", wantErr: false},
+		{name: "without space", value: "1กข1234", tag: "thai_plate", wantErr: false},
+		{name: "with hyphen", value: "กข-1234", tag: "thai_plate", wantErr: false},
+		{name: "with province", value: "1กข 1234 กรุงเทพมหานคร", tag: "thai_plate", wantErr: false},
+		{name: "with betong", value: "กข 99 เบตง", tag: "thai_plate", wantErr: false},
+		{name: "unknown province", value: "กข 1234 กรุงเทพ", tag: "thai_plate", wantErr: true},
+		{name: "leading zero number", value: "กข 0123", tag: "thai_plate", wantErr: true},
+		{name: "five digit number", value: "กข 12345", tag: "thai_plate", wantErr: true},
+		{name: "three consonants", value: "กขค 1234", tag: "thai_plate", wantErr: true},
+		{name: "digit prefix with one consonant", value: "1ก 1234", tag: "thai_plate", wantErr: true},
+		{name: "obsolete consonant", value: "ฃข 1234", tag: "thai_plate", wantErr: true},
+		{name: "vowel letter ru", value: "ฤก 1234", tag: "thai_plate", wantErr: true},
+		{name: "vowel letter lu", value: "1กฦ 1234", tag: "thai_plate", wantErr: true},
+		{name: "consonants around vowel letters", value: "รล 1234", tag: "thai_plate", wantErr: false},
+		{name: "last consonants", value: "วฮ 1234", tag: "thai_plate", wantErr: false},
+		{name: "latin letters", value: "AB 1234", tag: "thai_plate", wantErr: true},
+		{name: "empty string", value: "", tag: "thai_plate", wantErr: true},
+		{name: "non-string value", value: 1234, tag: "thai_plate", wantErr: true},
+		{name: "province required and present", value: "1กข 1234 เชียงใหม่", tag: "thai_plate=province", wantErr: false},
+		{name: "province required and missing", value: "1กข 1234", tag: "thai_plate=province", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := v.Var(tt.value, tt.tag)
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestThaiProvinces(t *testing.T) {
+	// 76 provinces, Bangkok and the Betong special plate area
+	assert.Len(t, thaiProvinces, 78)
+}
+
+func TestValidateThaiText(t *testing.T) {
+	v := validator.New()
+	RegisterThaiValidators(v)
+
+	tests := []struct {
+		name    string
+		value   interface{}
+		tag     string
+		wantErr bool
+	}{
+		{name: "thai name", value: "สมชาย", tag: "thai_text", wantErr: false},
+		{name: "thai full name with space", value: "สมชาย ใจดี", tag: "thai_text", wantErr: false},
+		{name: "thai digits", value: "บ้านเลขที่ ๑๒๓", tag: "thai_text", wantErr: false},
+		{name: "latin letters", value: "สมชาย Jaidee", tag: "thai_text", wantErr: true},
+		{name: "ascii digits without option", value: "ซอย 5", tag: "thai_text", wantErr: true},
+		{name: "ascii digits with option", value: "ซอย 5", tag: "thai_text=digits", wantErr: false},
+		{name: "spaces only", value: "   ", tag: "thai_text", wantErr: true},
+		{name: "tab not allowed", value: "สมชาย\tใจดี", tag: "thai_text", wantErr: true},
+		{name: "empty string", value: "", tag: "thai_text", wantErr: true},
+		{name: "non-string value", value: 1, tag: "thai_text", wantErr: true},
+		{name: "only: single word", value: "สมชาย", tag: "thai_text_only", wantErr: false},
+		{name: "only: space rejected", value: "สมชาย ใจดี", tag: "thai_text_only", wantErr: true},
+		{name: "only: space allowed by option", value: "สมชาย ใจดี", tag: "thai_text_only=spaces", wantErr: false},
+		{name: "only: spaces and digits", value: "หมู่ 5", tag: "thai_text_only=spaces digits", wantErr: false},
+		{name: "only: digits rejected", value: "หมู่5", tag: "thai_text_only", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := v.Var(tt.value, tt.tag)
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestValidateThaiText_InvalidParam(t *testing.T) {
+	v, err := NewValidator()
+	require.NoError(t, err)
+
+	err = v.Var("สมชาย", "thai_text=latin")
+
+	var configErr *ConfigError
+	require.ErrorAs(t, err, &configErr)
+	assert.Equal(t, "thai_text", configErr.Tag)
+}
+
+func TestParseBuddhistEraDate(t *testing.T) {
+	tests := []struct {
+		name    string
+		layout  string
+		value   string
+		want    time.Time
+		wantErr bool
+	}{
+		{name: "iso layout", layout: "2006-01-02", value: "2569-10-16", want: time.Date(2026, time.October, 16, 0, 0, 0, 0, time.UTC)},
+		{name: "thai day-first layout", layout: "02/01/2006", value: "16/10/2569", want: time.Date(2026, time.October, 16, 0, 0, 0, 0, time.UTC)},
+		{name: "compact layout", layout: "20060102", value: "25691016", want: time.Date(2026, time.October, 16, 0, 0, 0, 0, time.UTC)},
+		{name: "variable-width month name", layout: "2 January 2006", value: "16 October 2569", want: time.Date(2026, time.October, 16, 0, 0, 0, 0, time.UTC)},
+		{name: "leap day in CE leap year", layout: "2006-01-02", value: "2567-02-29", want: time.Date(2024, time.February, 29, 0, 0, 0, 0, time.UTC)},
+		{name: "leap day in CE common year", layout: "2006-01-02", value: "2568-02-29", wantErr: true},
+		{name: "invalid month", layout: "2006-01-02", value: "2569-13-01", wantErr: true},
+		{name: "year before CE", layout: "2006-01-02", value: "0500-01-01", wantErr: true},
+		{name: "layout without year", layout: "02/01", value: "16/10", wantErr: true},
+		{name: "malformed value", layout: "2006-01-02", value: "not a date", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseBuddhistEraDate(tt.layout, tt.value)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.True(t, tt.want.Equal(got), "got %s, want %s", got, tt.want)
+		})
+	}
+}
+
+func TestValidateDateBE(t *testing.T) {
+	v := validator.New()
+	RegisterThaiValidators(v)
+
+	tests := []struct {
+		name    string
+		value   interface{}
+		tag     string
+		wantErr bool
+	}{
+		{name: "default layout", value: "2569-10-16", tag: "date_be", wantErr: false},
+		{name: "custom layout", value: "16/10/2569", tag: "date_be=02/01/2006", wantErr: false},
+		{name: "leap day", value: "29/02/2567", tag: "date_be=02/01/2006", wantErr: false},
+		{name: "non-existent leap day", value: "29/02/2568", tag: "date_be=02/01/2006", wantErr: true},
+		{name: "layout mismatch", value: "2569-10-16", tag: "date_be=02/01/2006", wantErr: true},
+		{name: "empty string", value: "", tag: "date_be", wantErr: true},
+		{name: "non-string value", value: 2569, tag: "date_be", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := v.Var(tt.value, tt.tag)
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestValidateDateBE_FutureAndPast(t *testing.T) {
+	// 2026-10-16 CE is 2569-10-16 BE
+	now := time.Date(2026, 10, 16, 12, 0, 0, 0, time.UTC)
+	v, err := NewValidator(WithClock(func() time.Time { return now }))
+	require.NoError(t, err)
+
+	tests := []struct {
+		name    string
+		value   string
+		tag     string
+		wantErr bool
+	}{
+		{name: "future BE date", value: "2569-10-17", tag: "date_be=future", wantErr: false},
+		{name: "today is not future", value: "2569-10-16", tag: "date_be=future", wantErr: true},
+		{name: "past BE date", value: "2569-10-15", tag: "date_be=past", wantErr: false},
+		{name: "past BE date is not future", value: "2569-10-15", tag: "date_be=future", wantErr: true},
+		// A CE year read as BE lies 543 years earlier
+		{name: "CE year read as BE is past", value: "2026-10-17", tag: "date_be=past", wantErr: false},
+		{name: "CE year read as BE is not future", value: "2026-10-17", tag: "date_be=future", wantErr: true},
+		{name: "custom layout future", value: "01/01/2570", tag: "date_be=02/01/2006:future", wantErr: false},
+		{name: "custom layout past", value: "31/12/2568", tag: "date_be=02/01/2006:past", wantErr: false},
+		{name: "custom layout with time", value: "2569-10-16 13:00", tag: "date_be=2006-01-02 15:04:future", wantErr: false},
+		{name: "invalid date", value: "29/02/2570", tag: "date_be=02/01/2006:future", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := v.Var(tt.value, tt.tag)
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+
+	t.Run("translated", func(t *testing.T) {
+		err := v.VarTranslated("2569-10-15", "date_be=future")
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "must be a future Buddhist Era date in the format 2006-01-02")
+	})
+}
+
+func TestValidateDateBE_InvalidParam(t *testing.T) {
+	v, err := NewValidator()
+	require.NoError(t, err)
+
+	err = v.Var("16/10", "date_be=02/01")
+
+	var configErr *ConfigError
+	require.ErrorAs(t, err, &configErr)
+	assert.Equal(t, "date_be", configErr.Tag)
+}
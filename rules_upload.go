@@ -0,0 +1,146 @@
+package xvalidator
+
+import (
+	"mime"
+	"path"
+	"reflect"
+	"strings"
+	"unicode"
+
+	"github.com/go-playground/validator/v10"
+)
+
+// maxFilenameLength is the longest file name in bytes accepted by common file systems.
+const maxFilenameLength = 255
+
+// windowsReservedNames are device names that Windows refuses as file names, with or without an extension.
+var windowsReservedNames = map[string]bool{
+	"CON": true, "PRN": true, "AUX": true, "NUL": true,
+	"COM1": true, "COM2": true, "COM3": true, "COM4": true, "COM5": true, "COM6": true, "COM7": true, "COM8": true, "COM9": true,
+	"COM¹": true, "COM²": true, "COM³": true,
+	"LPT1": true, "LPT2": true, "LPT3": true, "LPT4": true, "LPT5": true, "LPT6": true, "LPT7": true, "LPT8": true, "LPT9": true,
+	"LPT¹": true, "LPT²": true, "LPT³": true,
+}
+
+// isSafeFilename reports whether name can be stored as a single file on Linux, macOS and Windows without
+// escaping its directory: it holds no path separators or characters Windows forbids, is not "." or "..",
+// does not start with a space or end with a dot or space, and is not a reserved Windows device name.
+func isSafeFilename(name string) bool {
+	if name == "" || len(name) > maxFilenameLength || name == "." || name == ".." {
+		return false
+	}
+	if strings.ContainsAny(name, `/\<>:"|?*`) || strings.IndexFunc(name, unicode.IsControl) >= 0 {
+		return false
+	}
+	if strings.HasSuffix(name, ".") || strings.HasSuffix(name, " ") || strings.HasPrefix(name, " ") {
+		return false
+	}
+
+	base, _, _ := strings.Cut(name, ".")
+	return !windowsReservedNames[strings.ToUpper(strings.TrimRight(base, " "))]
+}
+
+// filenameExtensions returns the lowercased extensions listed in a filename parameter, without dots.
+func filenameExtensions(param string) []string {
+	extensions := strings.Fields(strings.ToLower(param))
+	for i, extension := range extensions {
+		extensions[i] = strings.TrimPrefix(extension, ".")
+	}
+	return extensions
+}
+
+// validateFilename validates that a string is a safe file name for upload metadata: a single path element
+// without traversal, characters that are invalid on Windows or reserved Windows device names (CON, NUL,
+// COM1, ...). An optional space-separated allowlist restricts the extension, compared case-insensitively.
+// Usage:
+//   - `validate:"filename"` - e.g., "report-2026.pdf"
+//   - `validate:"filename=jpg jpeg png"` - images only
+func validateFilename(fl validator.FieldLevel) bool {
+	field := fl.Field()
+	if field.Kind() != reflect.String {
+		return false
+	}
+
+	name := field.String()
+	if !isSafeFilename(name) {
+		return false
+	}
+
+	extensions := filenameExtensions(fl.Param())
+	if len(extensions) == 0 {
+		return true
+	}
+	extension := strings.TrimPrefix(strings.ToLower(path.Ext(name)), ".")
+	for _, allowed := range extensions {
+		if extension != "" && extension == allowed {
+			return true
+		}
+	}
+	return false
+}
+
+// isMediaTypeName reports whether s is an RFC 6838 restricted name: up to 127 characters, starting with a
+// letter or digit, followed by letters, digits and "!#$&-^_.+".
+func isMediaTypeName(s string) bool {
+	if s == "" || len(s) > 127 || !isASCIIAlnum(s[0]) {
+		return false
+	}
+	for i := 1; i < len(s); i++ {
+		if !isASCIIAlnum(s[i]) && !strings.ContainsRune("!#$&-^_.+", rune(s[i])) {
+			return false
+		}
+	}
+	return true
+}
+
+// isASCIIAlnum reports whether c is an ASCII letter or digit.
+func isASCIIAlnum(c byte) bool {
+	return c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9'
+}
+
+// parseMediaType returns the lowercased "type/subtype" of a media type such as "text/plain; charset=utf-8".
+// Parameters must be well-formed and are otherwise ignored.
+func parseMediaType(value string) (string, bool) {
+	mediaType, _, err := mime.ParseMediaType(value)
+	if err != nil {
+		return "", false
+	}
+	typ, subtype, found := strings.Cut(mediaType, "/")
+	if !found || !isMediaTypeName(typ) || !isMediaTypeName(subtype) {
+		return "", false
+	}
+	return mediaType, true
+}
+
+// mediaTypeAllowed reports whether mediaType matches an allowlist entry, either exactly or through a
+// "type/*" wildcard.
+func mediaTypeAllowed(mediaType string, allowlist []string) bool {
+	for _, allowed := range allowlist {
+		allowed = strings.ToLower(allowed)
+		if allowed == mediaType || (strings.HasSuffix(allowed, "/*") && strings.HasPrefix(mediaType, allowed[:len(allowed)-1])) {
+			return true
+		}
+	}
+	return false
+}
+
+// validateMIMEType validates media type strings such as "image/png" or "text/csv; charset=utf-8" (RFC 6838
+// names, with optional well-formed parameters). An optional space-separated allowlist restricts the
+// type/subtype, compared case-insensitively; "type/*" allows every subtype.
+// Usage:
+//   - `validate:"mimetype"` - e.g., "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet"
+//   - `validate:"mimetype=image/png image/jpeg"` - PNG or JPEG only
+//   - `validate:"mimetype=image/* application/pdf"` - any image or PDF
+func validateMIMEType(fl validator.FieldLevel) bool {
+	field := fl.Field()
+	if field.Kind() != reflect.String {
+		return false
+	}
+
+	mediaType, ok := parseMediaType(field.String())
+	if !ok {
+		return false
+	}
+	allowlist := strings.Fields(fl.Param())
+	return len(allowlist) == 0 || mediaTypeAllowed(mediaType, allowlist)
+}
@@ -0,0 +1,104 @@
+package xvalidator
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateFilename(t *testing.T) {
+	v, err := NewValidator()
+	require.NoError(t, err)
+
+	tests := []struct {
+		name    string
+		value   string
+		tag     string
+		wantErr bool
+	}{
+		{"simple name", "report-2026.pdf", "filename", false},
+		{"no extension", "README", "filename", false},
+		{"dotfile", ".env", "filename", false},
+		{"double dots inside", "archive..old.zip", "filename", false},
+		{"thai name", "ใบเสร็จ มกราคม.pdf", "filename", false},
+		{"reserved name inside", "console.log", "filename", false},
+		{"allowed extension", "photo.JPG", "filename=jpg jpeg png", false},
+		{"allowed extension with dot", "scan.pdf", "filename=.pdf", false},
+		{"empty", "", "filename", true},
+		{"current directory", ".", "filename", true},
+		{"parent directory", "..", "filename", true},
+		{"traversal", "../etc/passwd", "filename", true},
+		{"windows separator", `..\boot.ini`, "filename", true},
+		{"absolute path", "/tmp/a.txt", "filename", true},
+		{"null byte", "a.txt\x00.jpg", "filename", true},
+		{"newline", "a\nb.txt", "filename", true},
+		{"windows invalid character", "what?.txt", "filename", true},
+		{"drive letter", "C:evil.txt", "filename", true},
+		{"trailing dot", "evil.exe.", "filename", true},
+		{"trailing space", "evil.exe ", "filename", true},
+		{"reserved name", "CON", "filename", true},
+		{"reserved name with extension", "nul.txt", "filename", true},
+		{"reserved name with superscript", "COM¹.log", "filename", true},
+		{"too long", strings.Repeat("a", 252) + ".txt", "filename", true},
+		{"disallowed extension", "setup.exe", "filename=jpg jpeg png", true},
+		{"double extension", "photo.jpg.exe", "filename=jpg", true},
+		{"missing extension", "photo", "filename=jpg", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := v.Var(tt.value, tt.tag)
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestValidateMIMEType(t *testing.T) {
+	v, err := NewValidator()
+	require.NoError(t, err)
+
+	tests := []struct {
+		name    string
+		value   string
+		tag     string
+		wantErr bool
+	}{
+		{"image", "image/png", "mimetype", false},
+		{"vendor type", "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet", "mimetype", false},
+		{"structured suffix", "application/problem+json", "mimetype", false},
+		{"parameters", "text/csv; charset=utf-8", "mimetype", false},
+		{"uppercase", "Image/PNG", "mimetype", false},
+		{"allowed", "image/jpeg", "mimetype=image/png image/jpeg", false},
+		{"allowed case-insensitively", "IMAGE/JPEG", "mimetype=image/png image/jpeg", false},
+		{"allowed with parameters", "text/plain; charset=utf-8", "mimetype=text/plain", false},
+		{"wildcard", "image/webp", "mimetype=image/* application/pdf", false},
+		{"empty", "", "mimetype", true},
+		{"missing subtype", "image", "mimetype", true},
+		{"empty subtype", "image/", "mimetype", true},
+		{"extra slash", "image/png/x", "mimetype", true},
+		{"space in subtype", "image/p ng", "mimetype", true},
+		{"subtype starting with symbol", "image/+png", "mimetype", true},
+		{"wildcard value", "image/*", "mimetype", true},
+		{"malformed parameter", "text/plain; charset", "mimetype", true},
+		{"not allowed", "image/gif", "mimetype=image/png image/jpeg", true},
+		{"wildcard does not match other types", "video/mp4", "mimetype=image/*", true},
+		{"prefix is not a match", "image/pngx", "mimetype=image/png", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := v.Var(tt.value, tt.tag)
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
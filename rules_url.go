@@ -0,0 +1,837 @@
+package xvalidator
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"mime"
+	"net/http"
+	"net/netip"
+	"net/url"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+	"unicode/utf8"
+
+	"github.com/go-playground/validator/v10"
+	"golang.org/x/net/idna"
+	"golang.org/x/net/publicsuffix"
+)
+
+// URL validation logic functions
+
+// validateURLScheme validates that the value parses as an absolute URL whose scheme is in the allowed set.
+// Schemes are space-separated and compared case-insensitively. Hierarchical URLs must have a host;
+// opaque URLs such as "mailto:user@example.com" must have a non-empty opaque part.
+// Usage:
+//   - `validate:"url_scheme=https wss"` - HTTPS or secure WebSocket endpoints
+//   - `validate:"url_scheme=sftp ftp"` - file transfer endpoints
+func validateURLScheme(fl validator.FieldLevel) bool {
+	schemes := strings.Fields(fl.Param())
+	if len(schemes) == 0 {
+		panicConfigError(fl, "expected at least one URL scheme")
+	}
+
+	field := fl.Field()
+	if field.Kind() != reflect.String {
+		return false
+	}
+
+	parsed, err := url.Parse(field.String())
+	if err != nil || parsed.Scheme == "" {
+		return false
+	}
+	if parsed.Opaque == "" && parsed.Host == "" {
+		return false
+	}
+
+	for _, scheme := range schemes {
+		if strings.EqualFold(parsed.Scheme, scheme) {
+			return true
+		}
+	}
+	return false
+}
+
+// parseURLHost parses an absolute URL and returns its host name in lower case without port or trailing dot.
+func parseURLHost(raw string) (string, bool) {
+	parsed, err := url.Parse(raw)
+	if err != nil || parsed.Scheme == "" {
+		return "", false
+	}
+
+	host := strings.TrimSuffix(strings.ToLower(parsed.Hostname()), ".")
+	return host, host != ""
+}
+
+// parseDomainPatterns returns the space-separated, lower-cased domain patterns of a rule parameter.
+func parseDomainPatterns(fl validator.FieldLevel) []string {
+	patterns := strings.Fields(strings.ToLower(fl.Param()))
+	if len(patterns) == 0 {
+		panicConfigError(fl, "expected at least one domain pattern")
+	}
+	for _, pattern := range patterns {
+		if pattern == "*" || pattern == "*." || strings.Contains(strings.TrimPrefix(pattern, "*."), "*") {
+			panicConfigError(fl, "wildcards are only supported as a leading '*.' label")
+		}
+	}
+	return patterns
+}
+
+// matchDomainPattern reports whether host matches a domain pattern.
+// "example.com" matches only that host; "*.example.com" matches any subdomain but not example.com itself.
+func matchDomainPattern(host, pattern string) bool {
+	if suffix, ok := strings.CutPrefix(pattern, "*"); ok {
+		return len(host) > len(suffix) && strings.HasSuffix(host, suffix)
+	}
+	return host == pattern
+}
+
+// validateURLDomainRule returns a validator that checks a URL's host against domain patterns.
+// With deny set, the host must match none of the patterns; otherwise it must match at least one.
+// Usage:
+//   - `validate:"url_domain=example.com *.trusted.co.th"` - host must be example.com or a trusted.co.th subdomain
+//   - `validate:"url_domain_deny=*.internal.example.com"` - host must not be an internal subdomain
+func validateURLDomainRule(deny bool) validator.Func {
+	return func(fl validator.FieldLevel) bool {
+		patterns := parseDomainPatterns(fl)
+
+		field := fl.Field()
+		if field.Kind() != reflect.String {
+			return false
+		}
+
+		host, ok := parseURLHost(field.String())
+		if !ok {
+			return false
+		}
+
+		for _, pattern := range patterns {
+			if matchDomainPattern(host, pattern) {
+				return !deny
+			}
+		}
+		return deny
+	}
+}
+
+// nonPublicPrefixes lists special-purpose ranges that are not covered by the netip.Addr predicates used in
+// isPublicIP but must not be reachable from user-supplied URLs.
+var nonPublicPrefixes = []netip.Prefix{
+	netip.MustParsePrefix("0.0.0.0/8"),       // "this network"
+	netip.MustParsePrefix("100.64.0.0/10"),   // carrier-grade NAT, includes the 100.100.100.200 metadata service
+	netip.MustParsePrefix("192.0.0.0/24"),    // IETF protocol assignments
+	netip.MustParsePrefix("192.0.2.0/24"),    // documentation (TEST-NET-1)
+	netip.MustParsePrefix("198.18.0.0/15"),   // benchmarking
+	netip.MustParsePrefix("198.51.100.0/24"), // documentation (TEST-NET-2)
+	netip.MustParsePrefix("203.0.113.0/24"),  // documentation (TEST-NET-3)
+	netip.MustParsePrefix("240.0.0.0/4"),     // reserved, includes the broadcast address
+	netip.MustParsePrefix("2001:db8::/32"),   // documentation
+	netip.MustParsePrefix("64:ff9b:1::/48"),  // local-use NAT64
+}
+
+// Translation prefixes whose addresses embed an IPv4 address, which isPublicIP checks in their place.
+var (
+	nat64Prefix     = netip.MustParsePrefix("64:ff9b::/96") // well-known NAT64 prefix, IPv4 in the last 32 bits
+	sixToFourPrefix = netip.MustParsePrefix("2002::/16")    // 6to4, IPv4 in the 32 bits after the prefix
+)
+
+// nonPublicHostNames lists host names that always refer to local or cloud-internal services.
+var nonPublicHostNames = map[string]bool{
+	"localhost":                true,
+	"metadata.google.internal": true,
+}
+
+// embeddedIPv4 returns the IPv4 address embedded in a NAT64 or 6to4 IPv6 address.
+func embeddedIPv4(addr netip.Addr) (netip.Addr, bool) {
+	b := addr.As16()
+	switch {
+	case nat64Prefix.Contains(addr):
+		return netip.AddrFrom4([4]byte(b[12:16])), true
+	case sixToFourPrefix.Contains(addr):
+		return netip.AddrFrom4([4]byte(b[2:6])), true
+	default:
+		return netip.Addr{}, false
+	}
+}
+
+// isPublicIP reports whether addr is a globally reachable unicast address. Loopback, private,
+// link-local (including the 169.254.169.254 metadata service), multicast, unspecified and other
+// special-purpose addresses are not public. IPv4-mapped, NAT64 and 6to4 IPv6 addresses are checked as
+// the IPv4 address they embed, since they route to it.
+func isPublicIP(addr netip.Addr) bool {
+	addr = addr.Unmap()
+	if !addr.IsValid() || addr.IsLoopback() || addr.IsPrivate() || addr.IsUnspecified() ||
+		addr.IsLinkLocalUnicast() || addr.IsMulticast() {
+		return false
+	}
+	for _, prefix := range nonPublicPrefixes {
+		if prefix.Contains(addr) {
+			return false
+		}
+	}
+	if v4, ok := embeddedIPv4(addr); ok {
+		return isPublicIP(v4)
+	}
+	return true
+}
+
+// isNumericHost reports whether every dot-separated label of host is a decimal, octal or hexadecimal number.
+// Such hosts (e.g., "2130706433" or "0x7f.1") are interpreted as IPv4 addresses by many HTTP clients.
+func isNumericHost(host string) bool {
+	for _, label := range strings.Split(host, ".") {
+		digits := label
+		if len(label) > 2 && (label[:2] == "0x" || label[:2] == "0X") {
+			digits = label[2:]
+			for _, r := range digits {
+				if !strings.ContainsRune("0123456789abcdefABCDEF", r) {
+					return false
+				}
+			}
+			continue
+		}
+		if !isASCIIDigits(digits) {
+			return false
+		}
+	}
+	return true
+}
+
+// isPublicHostName reports whether a host name, without resolving it, may refer to a public host.
+// Single-label names, localhost and well-known internal suffixes are rejected.
+func isPublicHostName(host string) bool {
+	if nonPublicHostNames[host] || !strings.Contains(host, ".") || isNumericHost(host) {
+		return false
+	}
+	for _, suffix := range []string{".localhost", ".local", ".internal"} {
+		if strings.HasSuffix(host, suffix) {
+			return false
+		}
+	}
+	return true
+}
+
+// validateURLPublic returns a validator rejecting URLs whose host is not publicly routable, so user-supplied
+// webhook URLs cannot be used for server-side request forgery (SSRF). IP literals must be public addresses
+// and host names must not be local or internal names.
+// With the resolve parameter, host names are also looked up using resolver and the context passed to
+// StructCtx or VarCtx, and every resolved address must be public. The HTTP client must still connect to the
+// checked addresses only, since DNS answers can change between validation and use.
+// Usage:
+//   - `validate:"url_public"` - check the host as written
+//   - `validate:"url_public=resolve"` - also resolve host names and check their addresses
+func validateURLPublic(resolver Resolver) validator.FuncCtx {
+	return func(ctx context.Context, fl validator.FieldLevel) bool {
+		var resolve bool
+		switch fl.Param() {
+		case "":
+		case "resolve":
+			resolve = true
+		default:
+			panicConfigError(fl, "expected no parameter or 'resolve'")
+		}
+
+		field := fl.Field()
+		if field.Kind() != reflect.String {
+			return false
+		}
+
+		host, ok := parseURLHost(field.String())
+		if !ok {
+			return false
+		}
+
+		if addr, err := netip.ParseAddr(host); err == nil {
+			return isPublicIP(addr)
+		}
+		if !isPublicHostName(host) {
+			return false
+		}
+		if !resolve {
+			return true
+		}
+
+		addrs, err := resolver.LookupIPAddr(ctx, host)
+		if err != nil || len(addrs) == 0 {
+			return false
+		}
+		for _, ipAddr := range addrs {
+			addr, ok := netip.AddrFromSlice(ipAddr.IP)
+			if !ok || !isPublicIP(addr) {
+				return false
+			}
+		}
+		return true
+	}
+}
+
+// defaultReachabilityTimeout bounds url_reachable requests when WithURLReachability gets no timeout.
+const defaultReachabilityTimeout = 5 * time.Second
+
+// reachabilityChecker sends rate-limited HEAD requests for the url_reachable rule.
+type reachabilityChecker struct {
+	client   *http.Client
+	timeout  time.Duration
+	interval time.Duration
+
+	mu   sync.Mutex
+	next time.Time
+}
+
+// newReachabilityChecker creates a reachabilityChecker; see WithURLReachability for the defaults.
+func newReachabilityChecker(client *http.Client, timeout, interval time.Duration) *reachabilityChecker {
+	if client == nil {
+		client = http.DefaultClient
+	}
+	if timeout <= 0 {
+		timeout = defaultReachabilityTimeout
+	}
+
+	// Copy the client so 3xx responses are returned instead of followed to hosts nobody validated
+	noRedirects := *client
+	noRedirects.CheckRedirect = func(*http.Request, []*http.Request) error {
+		return http.ErrUseLastResponse
+	}
+
+	return &reachabilityChecker{client: &noRedirects, timeout: timeout, interval: interval}
+}
+
+// wait blocks until the next request slot is free, or returns the context error if ctx ends first.
+func (c *reachabilityChecker) wait(ctx context.Context) error {
+	c.mu.Lock()
+	now := time.Now()
+	slot := c.next
+	if slot.Before(now) {
+		slot = now
+	}
+	c.next = slot.Add(c.interval)
+	c.mu.Unlock()
+
+	delay := time.Until(slot)
+	if delay <= 0 {
+		return nil
+	}
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// reachable sends a HEAD request to rawURL and reports whether it answered with a 2xx or 3xx status.
+func (c *reachabilityChecker) reachable(ctx context.Context, rawURL string) bool {
+	if err := c.wait(ctx); err != nil {
+		return false
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodHead, rawURL, nil)
+	if err != nil {
+		return false
+	}
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return false
+	}
+	resp.Body.Close()
+	return resp.StatusCode >= 200 && resp.StatusCode < 400
+}
+
+// validateURLReachable returns a validator checking that an http or https URL answers a HEAD request
+// with a 2xx or 3xx status, using the context passed to StructCtx or VarCtx.
+// The rule is opt-in: without WithURLReachability it reports a ConfigError. It does not check where the
+// URL points, so combine it with url_public for user-supplied URLs.
+// Usage:
+//   - `validate:"url_public=resolve,url_reachable"` - verify a webhook endpoint at registration time
+func validateURLReachable(checker *reachabilityChecker) validator.FuncCtx {
+	return func(ctx context.Context, fl validator.FieldLevel) bool {
+		if checker == nil {
+			panicConfigError(fl, "url_reachable must be enabled with WithURLReachability")
+		}
+
+		field := fl.Field()
+		if field.Kind() != reflect.String {
+			return false
+		}
+
+		parsed, err := url.Parse(field.String())
+		if err != nil || (parsed.Scheme != "http" && parsed.Scheme != "https") || parsed.Host == "" {
+			return false
+		}
+		return checker.reachable(ctx, parsed.String())
+	}
+}
+
+// dataURIParams holds the parsed form of a datauri rule parameter.
+type dataURIParams struct {
+	mediaTypes []string
+	maxSize    int
+}
+
+// parseDataURIParams parses space-separated datauri options: media types such as "image/png" or "image/*",
+// and "max=N" limiting the decoded size to N bytes (0 means unlimited).
+func parseDataURIParams(fl validator.FieldLevel) dataURIParams {
+	var params dataURIParams
+	for _, option := range strings.Fields(fl.Param()) {
+		if value, ok := strings.CutPrefix(option, "max="); ok {
+			size, err := strconv.Atoi(value)
+			if err != nil || size <= 0 {
+				panicConfigError(fl, "max must be a positive number of bytes")
+			}
+			params.maxSize = size
+			continue
+		}
+
+		mediaType, subtype, ok := strings.Cut(strings.ToLower(option), "/")
+		if !ok || mediaType == "" || mediaType == "*" || subtype == "" {
+			panicConfigError(fl, "expected media types such as 'image/png' or 'image/*' and an optional 'max=N'")
+		}
+		params.mediaTypes = append(params.mediaTypes, option)
+	}
+	return params
+}
+
+// matchMediaType reports whether mediaType (e.g., "image/png") matches a pattern such as "image/png" or "image/*".
+func matchMediaType(mediaType, pattern string) bool {
+	if prefix, ok := strings.CutSuffix(pattern, "/*"); ok {
+		return strings.HasPrefix(mediaType, strings.ToLower(prefix)+"/")
+	}
+	return strings.EqualFold(mediaType, pattern)
+}
+
+// parseDataURI parses an RFC 2397 data URI ("data:[<mediatype>][;base64],<data>") and returns its
+// lower-cased media type, defaulting to "text/plain", and the decoded data size.
+func parseDataURI(uri string) (mediaType string, size int, ok bool) {
+	if len(uri) < len("data:") || !strings.EqualFold(uri[:len("data:")], "data:") {
+		return "", 0, false
+	}
+	header, data, hasComma := strings.Cut(uri[len("data:"):], ",")
+	if !hasComma {
+		return "", 0, false
+	}
+
+	header, isBase64 := strings.CutSuffix(header, ";base64")
+	if header != "" && !strings.HasPrefix(header, ";") {
+		parsed, _, err := mime.ParseMediaType(header)
+		if err != nil {
+			return "", 0, false
+		}
+		mediaType = parsed
+	} else {
+		if header != "" {
+			// Parameters without a type, e.g. "data:;charset=utf-8,...", apply to the default type
+			if _, _, err := mime.ParseMediaType("text/plain" + header); err != nil {
+				return "", 0, false
+			}
+		}
+		mediaType = "text/plain"
+	}
+
+	if isBase64 {
+		decoded, err := base64.StdEncoding.DecodeString(data)
+		if err != nil {
+			return "", 0, false
+		}
+		return mediaType, len(decoded), true
+	}
+
+	decoded, err := url.PathUnescape(data)
+	if err != nil {
+		return "", 0, false
+	}
+	return mediaType, len(decoded), true
+}
+
+// validateDataURI validates RFC 2397 data URIs in base64 or percent-encoded form, replacing the
+// go-playground datauri rule, which only accepts base64 data.
+// Space-separated options restrict the media type and the decoded size:
+//   - `validate:"datauri"` - any valid data URI
+//   - `validate:"datauri=image/png image/jpeg"` - PNG or JPEG only
+//   - `validate:"datauri=image/* max=65536"` - any image of at most 64 KiB decoded
+func validateDataURI(fl validator.FieldLevel) bool {
+	params := parseDataURIParams(fl)
+
+	field := fl.Field()
+	if field.Kind() != reflect.String {
+		return false
+	}
+
+	mediaType, size, ok := parseDataURI(field.String())
+	if !ok {
+		return false
+	}
+	if params.maxSize > 0 && size > params.maxSize {
+		return false
+	}
+	if len(params.mediaTypes) == 0 {
+		return true
+	}
+	for _, pattern := range params.mediaTypes {
+		if matchMediaType(mediaType, pattern) {
+			return true
+		}
+	}
+	return false
+}
+
+// maxObjectKeyLength is the maximum length in bytes of S3 object keys and GCS object names.
+const maxObjectKeyLength = 1024
+
+// isBucketNameChars reports whether name consists of lowercase letters, digits and the given punctuation,
+// starts and ends with a letter or digit, has no adjacent periods and is not an IPv4 address.
+func isBucketNameChars(name, punctuation string) bool {
+	if name == "" || strings.Contains(name, "..") {
+		return false
+	}
+	for i := 0; i < len(name); i++ {
+		c := name[i]
+		alnum := (c >= 'a' && c <= 'z') || (c >= '0' && c <= '9')
+		if !alnum && (i == 0 || i == len(name)-1 || strings.IndexByte(punctuation, c) < 0) {
+			return false
+		}
+	}
+	_, err := netip.ParseAddr(name)
+	return err != nil
+}
+
+// isS3BucketName reports whether name follows the Amazon S3 general purpose bucket naming rules.
+func isS3BucketName(name string) bool {
+	if len(name) < 3 || len(name) > 63 || !isBucketNameChars(name, ".-") {
+		return false
+	}
+	for _, prefix := range []string{"xn--", "sthree-", "amzn-s3-demo-"} {
+		if strings.HasPrefix(name, prefix) {
+			return false
+		}
+	}
+	for _, suffix := range []string{"-s3alias", "--ol-s3", ".mrap", "--x-s3"} {
+		if strings.HasSuffix(name, suffix) {
+			return false
+		}
+	}
+	return true
+}
+
+// isGCSBucketName reports whether name follows the Google Cloud Storage bucket naming rules.
+// Names containing dots may be up to 222 characters, with each dot-separated component up to 63.
+func isGCSBucketName(name string) bool {
+	if len(name) < 3 || !isBucketNameChars(name, "-_.") {
+		return false
+	}
+	if strings.HasPrefix(name, "goog") || strings.Contains(name, "google") {
+		return false
+	}
+	if !strings.Contains(name, ".") {
+		return len(name) <= 63
+	}
+	if len(name) > 222 {
+		return false
+	}
+	for _, component := range strings.Split(name, ".") {
+		if len(component) > 63 {
+			return false
+		}
+	}
+	return true
+}
+
+// parseStorageURI splits a cloud storage URI such as "s3://bucket/key" into bucket and object key.
+// The key is empty for "s3://bucket" and "s3://bucket/".
+func parseStorageURI(uri, scheme string) (bucket, key string, ok bool) {
+	rest, found := strings.CutPrefix(uri, scheme+"://")
+	if !found {
+		return "", "", false
+	}
+	bucket, key, _ = strings.Cut(rest, "/")
+	return bucket, key, true
+}
+
+// validateS3URI validates Amazon S3 URIs of the form "s3://bucket" or "s3://bucket/key".
+// The bucket must follow the S3 bucket naming rules and the key must be valid UTF-8 of at most 1024 bytes.
+func validateS3URI(fl validator.FieldLevel) bool {
+	field := fl.Field()
+	if field.Kind() != reflect.String {
+		return false
+	}
+
+	bucket, key, ok := parseStorageURI(field.String(), "s3")
+	if !ok || !isS3BucketName(bucket) {
+		return false
+	}
+	return len(key) <= maxObjectKeyLength && utf8.ValidString(key)
+}
+
+// validateGCSURI validates Google Cloud Storage URIs of the form "gs://bucket" or "gs://bucket/object".
+// The bucket must follow the GCS bucket naming rules; the object name must be valid UTF-8 of at most
+// 1024 bytes without carriage returns or line feeds, and must not be "." or "..".
+func validateGCSURI(fl validator.FieldLevel) bool {
+	field := fl.Field()
+	if field.Kind() != reflect.String {
+		return false
+	}
+
+	bucket, object, ok := parseStorageURI(field.String(), "gs")
+	if !ok || !isGCSBucketName(bucket) {
+		return false
+	}
+	if len(object) > maxObjectKeyLength || !utf8.ValidString(object) || strings.ContainsAny(object, "\r\n") {
+		return false
+	}
+	return object != "." && object != ".."
+}
+
+// isPctEncoded reports whether s[i:] starts with a percent-encoded octet such as "%2F".
+func isPctEncoded(s string, i int) bool {
+	return i+2 < len(s) && s[i] == '%' && isHexDigit(s[i+1]) && isHexDigit(s[i+2])
+}
+
+// isHexDigit reports whether c is an ASCII hexadecimal digit.
+func isHexDigit(c byte) bool {
+	return (c >= '0' && c <= '9') || (c >= 'a' && c <= 'f') || (c >= 'A' && c <= 'F')
+}
+
+// isURITemplateVarspec reports whether spec is an RFC 6570 varspec: a variable name made of letters, digits,
+// "_" and percent-encoded octets (with single dots between them), optionally followed by a prefix modifier
+// ":1" to ":9999" or the explode modifier "*".
+func isURITemplateVarspec(spec string) bool {
+	name := spec
+	if before, ok := strings.CutSuffix(spec, "*"); ok {
+		name = before
+	} else if before, maxLength, ok := strings.Cut(spec, ":"); ok {
+		if len(maxLength) < 1 || len(maxLength) > 4 || maxLength[0] == '0' || !isASCIIDigits(maxLength) {
+			return false
+		}
+		name = before
+	}
+
+	if name == "" || name[0] == '.' || name[len(name)-1] == '.' {
+		return false
+	}
+	for i := 0; i < len(name); i++ {
+		c := name[i]
+		switch {
+		case (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z') || (c >= '0' && c <= '9') || c == '_':
+		case c == '.' && name[i-1] != '.':
+		case isPctEncoded(name, i):
+			i += 2
+		default:
+			return false
+		}
+	}
+	return true
+}
+
+// isURITemplate reports whether s is a valid RFC 6570 URI template (levels 1 to 4).
+func isURITemplate(s string) bool {
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		switch {
+		case c == '{':
+			end := strings.IndexByte(s[i+1:], '}')
+			if end < 0 {
+				return false
+			}
+			expression := s[i+1 : i+1+end]
+			if expression != "" && strings.IndexByte("+#./;?&", expression[0]) >= 0 {
+				expression = expression[1:]
+			}
+			for _, spec := range strings.Split(expression, ",") {
+				if !isURITemplateVarspec(spec) {
+					return false
+				}
+			}
+			i += end + 1
+		case c == '%':
+			if !isPctEncoded(s, i) {
+				return false
+			}
+			i += 2
+		case c >= 0x80:
+			// Non-ASCII literals (ucschar and iprivate) are allowed; they must form valid UTF-8
+			r, size := utf8.DecodeRuneInString(s[i:])
+			if r == utf8.RuneError {
+				return false
+			}
+			i += size - 1
+		case c <= ' ' || c == 0x7f || strings.IndexByte("\"'<>\\^`|}", c) >= 0:
+			return false
+		}
+	}
+	return true
+}
+
+// validateURITemplate validates RFC 6570 URI templates such as "/users/{id}{?fields,limit}", checking that
+// braces are balanced, expressions use a known operator and valid variable specifications, and literals
+// contain only allowed or percent-encoded characters.
+func validateURITemplate(fl validator.FieldLevel) bool {
+	field := fl.Field()
+	if field.Kind() != reflect.String {
+		return false
+	}
+	return isURITemplate(field.String())
+}
+
+// defaultPorts maps URL schemes to the port implied when none is given.
+var defaultPorts = map[string]string{
+	"http":  "80",
+	"https": "443",
+	"ws":    "80",
+	"wss":   "443",
+	"ftp":   "21",
+}
+
+// removeDotSegments removes "." and ".." segments from a URL path as described in RFC 3986 section 5.2.4.
+func removeDotSegments(p string) string {
+	var out []string
+	segments := strings.Split(p, "/")
+	for i, segment := range segments {
+		last := i == len(segments)-1
+		switch segment {
+		case ".":
+			if last {
+				out = append(out, "")
+			}
+		case "..":
+			if len(out) > 1 || (len(out) == 1 && out[0] != "") {
+				out = out[:len(out)-1]
+			}
+			if last {
+				out = append(out, "")
+			}
+		default:
+			out = append(out, segment)
+		}
+	}
+
+	result := strings.Join(out, "/")
+	if strings.HasPrefix(p, "/") && !strings.HasPrefix(result, "/") {
+		result = "/" + result
+	}
+	return result
+}
+
+// NormalizeURL returns the normalized form of an absolute URL: lower-case scheme and host, no default port
+// (e.g., ":443" for https), dot segments resolved and an empty path replaced by "/".
+// Query and fragment are kept as they are. It returns an error if rawURL is not an absolute URL with a host.
+func NormalizeURL(rawURL string) (string, error) {
+	parsed, err := url.Parse(rawURL)
+	if err != nil {
+		return "", fmt.Errorf("xvalidator: cannot parse URL %q: %w", rawURL, err)
+	}
+	if parsed.Scheme == "" || parsed.Host == "" {
+		return "", fmt.Errorf("xvalidator: URL %q must be absolute with a host", rawURL)
+	}
+
+	parsed.Scheme = strings.ToLower(parsed.Scheme)
+	host, port := strings.ToLower(parsed.Hostname()), parsed.Port()
+	if port == defaultPorts[parsed.Scheme] {
+		port = ""
+	}
+	if strings.Contains(host, ":") {
+		host = "[" + host + "]"
+	}
+	if port != "" {
+		host += ":" + port
+	}
+	parsed.Host = host
+
+	escapedPath := removeDotSegments(parsed.EscapedPath())
+	if escapedPath == "" {
+		escapedPath = "/"
+	}
+	parsed.Path, err = url.PathUnescape(escapedPath)
+	if err != nil {
+		return "", fmt.Errorf("xvalidator: cannot parse URL %q: %w", rawURL, err)
+	}
+	parsed.RawPath = escapedPath
+
+	return parsed.String(), nil
+}
+
+// validateURLMax validates that the value is an absolute URL with a scheme of at most N bytes. Non-ASCII
+// characters count as their UTF-8 length, matching storage and header limits rather than rune counts.
+// Usage:
+//   - `validate:"url_max=2048"` - URLs up to 2048 bytes
+func validateURLMax(fl validator.FieldLevel) bool {
+	maxLength, err := strconv.Atoi(fl.Param())
+	if err != nil || maxLength <= 0 {
+		panicConfigError(fl, "expected a positive maximum length")
+	}
+
+	field := fl.Field()
+	if field.Kind() != reflect.String {
+		return false
+	}
+
+	rawURL := field.String()
+	if len(rawURL) > maxLength {
+		return false
+	}
+	parsed, err := url.Parse(rawURL)
+	return err == nil && parsed.Scheme != "" && (parsed.Host != "" || parsed.Opaque != "")
+}
+
+// validateURLNormalized validates that the value is an absolute URL already in the form returned by
+// NormalizeURL, so non-canonical variants of the same URL are not stored. An empty path normalizes to "/", so
+// "https://example.com" fails and "https://example.com/" passes.
+func validateURLNormalized(fl validator.FieldLevel) bool {
+	field := fl.Field()
+	if field.Kind() != reflect.String {
+		return false
+	}
+
+	normalized, err := NormalizeURL(field.String())
+	return err == nil && normalized == field.String()
+}
+
+// isHostnameLabels reports whether host is an ASCII host name of at most 253 characters whose dot-separated
+// labels are 1 to 63 letters, digits and hyphens, not starting or ending with a hyphen.
+func isHostnameLabels(host string) bool {
+	if host == "" || len(host) > 253 {
+		return false
+	}
+	for _, label := range strings.Split(host, ".") {
+		if label == "" || len(label) > 63 || label[0] == '-' || label[len(label)-1] == '-' {
+			return false
+		}
+		for i := 0; i < len(label); i++ {
+			c := label[i]
+			if !(c >= 'a' && c <= 'z') && !(c >= '0' && c <= '9') && c != '-' {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// validateFQDNPublic validates that a host name has a registrable domain under the Public Suffix List,
+// e.g. "shop.example.co.th" (registrable domain "example.co.th"). Bare public suffixes such as "co.th" or
+// "com", single-label names and names under unlisted TLDs such as ".internal" or ".local" are rejected.
+// Internationalized names are converted to their ASCII (punycode) form before the check.
+func validateFQDNPublic(fl validator.FieldLevel) bool {
+	field := fl.Field()
+	if field.Kind() != reflect.String {
+		return false
+	}
+
+	host, err := idna.Lookup.ToASCII(strings.TrimSuffix(field.String(), "."))
+	if err != nil || !isHostnameLabels(host) || isNumericHost(host) {
+		return false
+	}
+
+	suffix, icann := publicsuffix.PublicSuffix(host)
+	if !icann && !strings.Contains(suffix, ".") {
+		// Unlisted TLDs fall back to the default "*" rule, which is not an ICANN suffix
+		return false
+	}
+	_, err = publicsuffix.EffectiveTLDPlusOne(host)
+	return err == nil
+}
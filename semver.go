@@ -0,0 +1,400 @@
+package xvalidator
+
+import (
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// semverVersion is a parsed SemVer 2.0.0 version. Build metadata is ignored for precedence and not kept.
+type semverVersion struct {
+	major, minor, patch uint64
+	pre                 []string
+}
+
+// isSemverNumber reports whether s is a numeric identifier without leading zeros.
+func isSemverNumber(s string) bool {
+	if s == "" || (len(s) > 1 && s[0] == '0') {
+		return false
+	}
+	return strings.IndexFunc(s, func(r rune) bool { return r < '0' || r > '9' }) < 0
+}
+
+// isSemverIdentifier reports whether s is a non-empty run of ASCII alphanumerics and hyphens.
+func isSemverIdentifier(s string) bool {
+	if s == "" {
+		return false
+	}
+	return strings.IndexFunc(s, func(r rune) bool {
+		return !(r >= '0' && r <= '9' || r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r == '-')
+	}) < 0
+}
+
+// parseSemverIdentifiers splits dot-separated pre-release or build identifiers; numeric pre-release
+// identifiers must not have leading zeros.
+func parseSemverIdentifiers(s string, prerelease bool) ([]string, bool) {
+	identifiers := strings.Split(s, ".")
+	for _, identifier := range identifiers {
+		if !isSemverIdentifier(identifier) {
+			return nil, false
+		}
+		if prerelease && identifier[0] == '0' && len(identifier) > 1 &&
+			strings.IndexFunc(identifier, func(r rune) bool { return r < '0' || r > '9' }) < 0 {
+			return nil, false
+		}
+	}
+	return identifiers, true
+}
+
+// parseSemver parses a strict SemVer 2.0.0 version such as "1.4.0-rc.1+build.5", without a "v" prefix.
+func parseSemver(s string) (semverVersion, bool) {
+	s, build, hasBuild := strings.Cut(s, "+")
+	if hasBuild {
+		if _, ok := parseSemverIdentifiers(build, false); !ok {
+			return semverVersion{}, false
+		}
+	}
+
+	core, pre, hasPre := strings.Cut(s, "-")
+	var version semverVersion
+	if hasPre {
+		identifiers, ok := parseSemverIdentifiers(pre, true)
+		if !ok {
+			return semverVersion{}, false
+		}
+		version.pre = identifiers
+	}
+
+	parts := strings.Split(core, ".")
+	if len(parts) != 3 {
+		return semverVersion{}, false
+	}
+	numbers := [3]*uint64{&version.major, &version.minor, &version.patch}
+	for i, part := range parts {
+		if !isSemverNumber(part) {
+			return semverVersion{}, false
+		}
+		n, err := strconv.ParseUint(part, 10, 64)
+		if err != nil {
+			return semverVersion{}, false
+		}
+		*numbers[i] = n
+	}
+	return version, true
+}
+
+// compareSemverIdentifiers compares pre-release identifiers by SemVer precedence: numeric identifiers compare
+// numerically and sort before alphanumeric ones.
+func compareSemverIdentifiers(a, b string) int {
+	aNum, aErr := strconv.ParseUint(a, 10, 64)
+	bNum, bErr := strconv.ParseUint(b, 10, 64)
+	switch {
+	case aErr == nil && bErr == nil:
+		return compareUint(aNum, bNum)
+	case aErr == nil:
+		return -1
+	case bErr == nil:
+		return 1
+	default:
+		return strings.Compare(a, b)
+	}
+}
+
+// compareUint returns -1, 0 or 1 as a is less than, equal to or greater than b.
+func compareUint(a, b uint64) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	default:
+		return 0
+	}
+}
+
+// compare returns -1, 0 or 1 as v has lower, equal or higher precedence than other.
+func (v semverVersion) compare(other semverVersion) int {
+	if c := compareUint(v.major, other.major); c != 0 {
+		return c
+	}
+	if c := compareUint(v.minor, other.minor); c != 0 {
+		return c
+	}
+	if c := compareUint(v.patch, other.patch); c != 0 {
+		return c
+	}
+
+	// A version without pre-release identifiers has higher precedence
+	switch {
+	case len(v.pre) == 0 && len(other.pre) == 0:
+		return 0
+	case len(v.pre) == 0:
+		return 1
+	case len(other.pre) == 0:
+		return -1
+	}
+	for i := 0; i < len(v.pre) && i < len(other.pre); i++ {
+		if c := compareSemverIdentifiers(v.pre[i], other.pre[i]); c != 0 {
+			return c
+		}
+	}
+	return compareUint(uint64(len(v.pre)), uint64(len(other.pre)))
+}
+
+// sameCore reports whether v and other have the same major, minor and patch numbers.
+func (v semverVersion) sameCore(other semverVersion) bool {
+	return v.major == other.major && v.minor == other.minor && v.patch == other.patch
+}
+
+// semverComparator is a single bound such as ">=1.2.0". explicitPre is set when the constraint itself names
+// a pre-release of that version, which allows pre-releases of the same version to match.
+type semverComparator struct {
+	op          string
+	version     semverVersion
+	explicitPre bool
+}
+
+// matches reports whether version satisfies the comparator.
+func (c semverComparator) matches(version semverVersion) bool {
+	cmp := version.compare(c.version)
+	switch c.op {
+	case "=":
+		return cmp == 0
+	case "!=":
+		return cmp != 0
+	case ">":
+		return cmp > 0
+	case ">=":
+		return cmp >= 0
+	case "<":
+		return cmp < 0
+	default:
+		return cmp <= 0
+	}
+}
+
+// semverConstraint is a union of comparator sets; a version must satisfy every comparator of one set.
+type semverConstraint [][]semverComparator
+
+// semverConstraintCache caches parseSemverConstraint results keyed by the raw tag parameter.
+var semverConstraintCache sync.Map
+
+// matches reports whether version satisfies the constraint. Pre-release versions only match a set that names a
+// pre-release of the same major.minor.patch, so "^1.0.0" does not accept "1.5.0-beta".
+func (c semverConstraint) matches(version semverVersion) bool {
+	for _, set := range c {
+		allowed := len(version.pre) == 0
+		matched := true
+		for _, comparator := range set {
+			if !comparator.matches(version) {
+				matched = false
+				break
+			}
+			if comparator.explicitPre && comparator.version.sameCore(version) {
+				allowed = true
+			}
+		}
+		if matched && allowed {
+			return true
+		}
+	}
+	return false
+}
+
+// partialSemver is a version in a constraint, where trailing components may be missing or wildcards.
+type partialSemver struct {
+	version semverVersion
+	parts   int // number of specified components: 0 for "*", up to 3
+}
+
+// parsePartialSemver parses constraint versions such as "1", "1.2", "1.2.x", "*" and "1.2.3-beta".
+// A leading "v" is tolerated in constraints.
+func parsePartialSemver(s string) (partialSemver, bool) {
+	s = strings.TrimPrefix(s, "v")
+	if s == "" {
+		return partialSemver{}, false
+	}
+
+	core, rest := s, ""
+	if i := strings.IndexAny(s, "-+"); i >= 0 {
+		core, rest = s[:i], s[i:]
+	}
+
+	components := strings.Split(core, ".")
+	if len(components) > 3 {
+		return partialSemver{}, false
+	}
+
+	var partial partialSemver
+	numbers := [3]*uint64{&partial.version.major, &partial.version.minor, &partial.version.patch}
+	for i, component := range components {
+		if component == "x" || component == "X" || component == "*" {
+			break
+		}
+		if !isSemverNumber(component) {
+			return partialSemver{}, false
+		}
+		n, err := strconv.ParseUint(component, 10, 64)
+		if err != nil {
+			return partialSemver{}, false
+		}
+		*numbers[i] = n
+		partial.parts++
+	}
+	for _, component := range components[partial.parts:] {
+		if component != "x" && component != "X" && component != "*" {
+			return partialSemver{}, false
+		}
+	}
+
+	if rest != "" {
+		if partial.parts < 3 {
+			return partialSemver{}, false
+		}
+		version, ok := parseSemver(core + rest)
+		if !ok {
+			return partialSemver{}, false
+		}
+		partial.version = version
+	}
+	return partial, true
+}
+
+// next returns the lowest version above every version matching the first parts components of p,
+// as a "-0" pre-release so pre-releases of it are excluded too.
+func (p partialSemver) next(parts int) semverVersion {
+	v := semverVersion{major: p.version.major, minor: p.version.minor, patch: p.version.patch, pre: []string{"0"}}
+	switch parts {
+	case 1:
+		return semverVersion{major: v.major + 1, pre: v.pre}
+	case 2:
+		return semverVersion{major: v.major, minor: v.minor + 1, pre: v.pre}
+	default:
+		v.patch++
+		return v
+	}
+}
+
+// floor returns the lowest version matching p, as a release.
+func (p partialSemver) floor() semverVersion {
+	if p.parts == 3 {
+		return p.version
+	}
+	return semverVersion{major: p.version.major, minor: p.version.minor, patch: p.version.patch}
+}
+
+// parseSemverComparator expands one constraint term, such as "^1.2.3", "~1.2", ">=1.0" or "1.x",
+// into comparators.
+func parseSemverComparator(term string) ([]semverComparator, bool) {
+	op := ""
+	for _, candidate := range []string{">=", "<=", "!=", ">", "<", "=", "^", "~"} {
+		if strings.HasPrefix(term, candidate) {
+			op, term = candidate, term[len(candidate):]
+			break
+		}
+	}
+
+	partial, ok := parsePartialSemver(term)
+	if !ok {
+		return nil, false
+	}
+	explicitPre := len(partial.version.pre) > 0
+	floor := semverComparator{op: ">=", version: partial.floor(), explicitPre: explicitPre}
+
+	switch op {
+	case "^":
+		// Allow changes that keep the leftmost non-zero component specified
+		parts := 1
+		switch {
+		case partial.version.major == 0 && partial.version.minor == 0 && partial.parts == 3:
+			parts = 3
+		case partial.version.major == 0 && partial.parts >= 2:
+			parts = 2
+		case partial.parts == 0:
+			return []semverComparator{floor}, true
+		}
+		return []semverComparator{floor, {op: "<", version: partial.next(parts)}}, true
+	case "~":
+		parts := 2
+		if partial.parts == 1 {
+			parts = 1
+		} else if partial.parts == 0 {
+			return []semverComparator{floor}, true
+		}
+		return []semverComparator{floor, {op: "<", version: partial.next(parts)}}, true
+	case "", "=":
+		if partial.parts == 3 {
+			return []semverComparator{{op: "=", version: partial.version, explicitPre: explicitPre}}, true
+		}
+		if partial.parts == 0 {
+			return []semverComparator{floor}, true
+		}
+		return []semverComparator{floor, {op: "<", version: partial.next(partial.parts)}}, true
+	case "!=":
+		if partial.parts != 3 {
+			return nil, false
+		}
+		return []semverComparator{{op: "!=", version: partial.version}}, true
+	}
+
+	if partial.parts == 0 {
+		// ">=*" and "<=*" match everything; ">*" and "<*" match nothing
+		if op == ">=" || op == "<=" {
+			return []semverComparator{floor}, true
+		}
+		return []semverComparator{{op: "<", version: semverVersion{pre: []string{"0"}}}}, true
+	}
+	if partial.parts == 3 {
+		return []semverComparator{{op: op, version: partial.version, explicitPre: explicitPre}}, true
+	}
+
+	// Partial versions compare against the range they cover: ">1.2" means ">=1.3.0", "<=1.2" means "<1.3.0-0"
+	switch op {
+	case ">":
+		above := partial.next(partial.parts)
+		above.pre = nil
+		return []semverComparator{{op: ">=", version: above}}, true
+	case ">=":
+		return []semverComparator{floor}, true
+	case "<":
+		return []semverComparator{{op: "<", version: semverVersion{
+			major: partial.version.major, minor: partial.version.minor, pre: []string{"0"},
+		}}}, true
+	default:
+		return []semverComparator{{op: "<", version: partial.next(partial.parts)}}, true
+	}
+}
+
+// parseSemverConstraint parses a version range: space-separated terms must all match, and "||"
+// separates alternatives ("^1.2.0 || ^2.0.0"; inside struct tags write "0x7C0x7C").
+func parseSemverConstraint(param string) (semverConstraint, bool) {
+	if cached, ok := semverConstraintCache.Load(param); ok {
+		return cached.(semverConstraint), true
+	}
+
+	var constraint semverConstraint
+	for _, alternative := range strings.Split(param, "||") {
+		// Allow a space between an operator and its version, as in ">= 1.2.0"
+		terms := strings.Fields(alternative)
+		var set []semverComparator
+		for i := 0; i < len(terms); i++ {
+			term := terms[i]
+			if strings.Trim(term, "<>=!^~") == "" && i+1 < len(terms) {
+				i++
+				term += terms[i]
+			}
+			comparators, ok := parseSemverComparator(term)
+			if !ok {
+				return nil, false
+			}
+			set = append(set, comparators...)
+		}
+		if len(set) == 0 {
+			return nil, false
+		}
+		constraint = append(constraint, set)
+	}
+
+	semverConstraintCache.Store(param, constraint)
+	return constraint, true
+}
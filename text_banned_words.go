@@ -0,0 +1,82 @@
+package xvalidator
+
+import (
+	_ "embed"
+	"strings"
+	"sync"
+	"unicode"
+)
+
+// bannedWordsData is the embedded default list of banned words, one per line.
+// Lines starting with "#" are comments.
+//
+//go:embed banned_words.txt
+var bannedWordsData string
+
+// WordList reports banned words for the clean_text rule. NewWordList builds one from a list of words;
+// implement it to back clean_text with another source, such as a moderation service.
+type WordList interface {
+	// ContainsBannedWord reports whether text contains a banned word.
+	ContainsBannedWord(text string) bool
+}
+
+// wordList is the WordList built by NewWordList.
+type wordList struct {
+	phrases   []string // Latin-script words and phrases, normalized and padded with spaces
+	fragments []string // words in scripts written without spaces, lowercased
+}
+
+// NewWordList returns a WordList matching the given words and phrases case-insensitively. Words written with
+// spaces between words, such as English, match whole words only ("ass" does not match "class"); Thai words
+// match anywhere in the text.
+func NewWordList(words []string) WordList {
+	list := &wordList{}
+	for _, word := range words {
+		word = strings.ToLower(strings.TrimSpace(word))
+		switch {
+		case word == "":
+		case strings.IndexFunc(word, func(r rune) bool { return unicode.Is(unicode.Thai, r) }) >= 0:
+			list.fragments = append(list.fragments, word)
+		default:
+			list.phrases = append(list.phrases, " "+normalizeWords(word)+" ")
+		}
+	}
+	return list
+}
+
+// normalizeWords lowercases text and joins its words (runs of letters, marks and digits) with single spaces.
+func normalizeWords(text string) string {
+	words := strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsMark(r) && !unicode.IsDigit(r)
+	})
+	return strings.Join(words, " ")
+}
+
+// ContainsBannedWord implements WordList.
+func (l *wordList) ContainsBannedWord(text string) bool {
+	lower := strings.ToLower(text)
+	for _, fragment := range l.fragments {
+		if strings.Contains(lower, fragment) {
+			return true
+		}
+	}
+
+	normalized := " " + normalizeWords(text) + " "
+	for _, phrase := range l.phrases {
+		if strings.Contains(normalized, phrase) {
+			return true
+		}
+	}
+	return false
+}
+
+// DefaultBannedWords returns the small embedded English and Thai list used by clean_text by default.
+// Combine it with your own words in NewWordList to extend it.
+func DefaultBannedWords() []string {
+	return parseEmbeddedList(bannedWordsData)
+}
+
+// defaultWordList builds the WordList of the embedded words once, on first use.
+var defaultWordList = sync.OnceValue(func() WordList {
+	return NewWordList(DefaultBannedWords())
+})
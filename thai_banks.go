@@ -0,0 +1,47 @@
+package xvalidator
+
+// thaiBank describes a bank in the Bank of Thailand financial institution code registry.
+type thaiBank struct {
+	name string
+	// accountLengths lists the digit counts of the bank's deposit account numbers;
+	// nil when the bank uses no single published length, in which case 10 to 12 digits are accepted.
+	accountLengths []int
+}
+
+// thaiBanks maps 3-digit Bank of Thailand bank codes to the banks operating deposit accounts in Thailand.
+var thaiBanks = map[string]thaiBank{
+	"002": {name: "Bangkok Bank", accountLengths: []int{10}},
+	"004": {name: "Kasikornbank", accountLengths: []int{10}},
+	"006": {name: "Krung Thai Bank", accountLengths: []int{10}},
+	"011": {name: "TMBThanachart Bank", accountLengths: []int{10}},
+	"014": {name: "Siam Commercial Bank", accountLengths: []int{10}},
+	"017": {name: "Citibank"},
+	"018": {name: "Sumitomo Mitsui Banking Corporation"},
+	"020": {name: "Standard Chartered Bank (Thai)"},
+	"022": {name: "CIMB Thai Bank", accountLengths: []int{10}},
+	"024": {name: "United Overseas Bank (Thai)", accountLengths: []int{10}},
+	"025": {name: "Bank of Ayudhya", accountLengths: []int{10}},
+	"030": {name: "Government Savings Bank", accountLengths: []int{12}},
+	"031": {name: "HSBC"},
+	"032": {name: "Deutsche Bank"},
+	"033": {name: "Government Housing Bank", accountLengths: []int{12}},
+	"034": {name: "Bank for Agriculture and Agricultural Cooperatives", accountLengths: []int{12}},
+	"035": {name: "Export-Import Bank of Thailand"},
+	"039": {name: "Mizuho Bank"},
+	"045": {name: "BNP Paribas"},
+	"052": {name: "Bank of China (Thai)"},
+	"066": {name: "Islamic Bank of Thailand", accountLengths: []int{12}},
+	"067": {name: "TISCO Bank", accountLengths: []int{10}},
+	"069": {name: "Kiatnakin Phatra Bank", accountLengths: []int{10}},
+	"070": {name: "Industrial and Commercial Bank of China (Thai)", accountLengths: []int{10}},
+	"071": {name: "Thai Credit Bank", accountLengths: []int{10}},
+	"073": {name: "Land and Houses Bank", accountLengths: []int{10}},
+	"098": {name: "SME Development Bank"},
+}
+
+// ThaiBankName returns the name of the bank registered under a 3-digit Bank of Thailand bank code.
+// Returns false when the code is unknown.
+func ThaiBankName(code string) (string, bool) {
+	bank, ok := thaiBanks[code]
+	return bank.name, ok
+}
@@ -0,0 +1,23 @@
+package xvalidator
+
+// thaiProvinces lists the Thai names of the 77 provinces as printed on vehicle license plates,
+// plus Betong, which has issued its own plates since 2021.
+var thaiProvinces = map[string]struct{}{
+	"กรุงเทพมหานคร": {}, "กระบี่": {}, "กาญจนบุรี": {}, "กาฬสินธุ์": {}, "กำแพงเพชร": {},
+	"ขอนแก่น": {}, "จันทบุรี": {}, "ฉะเชิงเทรา": {}, "ชลบุรี": {}, "ชัยนาท": {},
+	"ชัยภูมิ": {}, "ชุมพร": {}, "เชียงราย": {}, "เชียงใหม่": {}, "ตรัง": {},
+	"ตราด": {}, "ตาก": {}, "นครนายก": {}, "นครปฐม": {}, "นครพนม": {},
+	"นครราชสีมา": {}, "นครศรีธรรมราช": {}, "นครสวรรค์": {}, "นนทบุรี": {}, "นราธิวาส": {},
+	"น่าน": {}, "บึงกาฬ": {}, "บุรีรัมย์": {}, "ปทุมธานี": {}, "ประจวบคีรีขันธ์": {},
+	"ปราจีนบุรี": {}, "ปัตตานี": {}, "พระนครศรีอยุธยา": {}, "พะเยา": {}, "พังงา": {},
+	"พัทลุง": {}, "พิจิตร": {}, "พิษณุโลก": {}, "เพชรบุรี": {}, "เพชรบูรณ์": {},
+	"แพร่": {}, "ภูเก็ต": {}, "มหาสารคาม": {}, "มุกดาหาร": {}, "แม่ฮ่องสอน": {},
+	"ยโสธร": {}, "ยะลา": {}, "ร้อยเอ็ด": {}, "ระนอง": {}, "ระยอง": {},
+	"ราชบุรี": {}, "ลพบุรี": {}, "ลำปาง": {}, "ลำพูน": {}, "เลย": {},
+	"ศรีสะเกษ": {}, "สกลนคร": {}, "สงขลา": {}, "สตูล": {}, "สมุทรปราการ": {},
+	"สมุทรสงคราม": {}, "สมุทรสาคร": {}, "สระแก้ว": {}, "สระบุรี": {}, "สิงห์บุรี": {},
+	"สุโขทัย": {}, "สุพรรณบุรี": {}, "สุราษฎร์ธานี": {}, "สุรินทร์": {}, "หนองคาย": {},
+	"หนองบัวลำภู": {}, "อ่างทอง": {}, "อำนาจเจริญ": {}, "อุดรธานี": {}, "อุตรดิตถ์": {},
+	"อุทัยธานี": {}, "อุบลราชธานี": {},
+	"เบตง": {},
+}
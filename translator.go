@@ -2,6 +2,7 @@ package xvalidator
 
 import (
 	"fmt"
+	"strconv"
 	"strings"
 
 	"github.com/go-playground/locales/en"
@@ -11,7 +12,7 @@ import (
 )
 
 // setupTranslator creates and configures an English translator for validation messages
-func setupTranslator(v *validator.Validate) (ut.Translator, error) {
+func setupTranslator(v *validator.Validate, o options) (ut.Translator, error) {
 	// Setup English translator
 	en := en.New()
 	uni := ut.New(en, en)
@@ -24,7 +25,7 @@ func setupTranslator(v *validator.Validate) (ut.Translator, error) {
 	}
 
 	// Register custom translations for our custom validators
-	err = registerCustomTranslations(v, trans)
+	err = registerCustomTranslations(v, trans, o)
 	if err != nil {
 		return nil, fmt.Errorf("failed to register custom translations: %w", err)
 	}
@@ -76,11 +77,35 @@ func registerDecimalTranslation(v *validator.Validate, trans ut.Translator) erro
 	return nil
 }
 
+// registerDecimalLooseTranslation registers decimal_loose validation translation with custom formatting
+func registerDecimalLooseTranslation(v *validator.Validate, trans ut.Translator) error {
+	err := v.RegisterTranslation("decimal_loose", trans, func(ut ut.Translator) error {
+		return ut.Add("decimal_loose", "{0} must be a decimal with precision ≤ {1} and scale ≤ {2}", false)
+	}, func(ut ut.Translator, fe validator.FieldError) string {
+		precision, scale := parseDecimalParams(fe.Param())
+
+		// Special case for integer format (scale = 0)
+		if scale == 0 {
+			return fmt.Sprintf("%s must be an integer format (no decimal places)", fe.Field())
+		}
+
+		translated, _ := ut.T("decimal_loose", fe.Field(),
+			fmt.Sprintf("%d", precision),
+			fmt.Sprintf("%d", scale))
+		return translated
+	})
+	if err != nil {
+		return fmt.Errorf("failed to register decimal_loose translation: %w", err)
+	}
+
+	return nil
+}
+
 // registerDecimalIfTranslation registers decimal_if validation translation with custom formatting
 func registerDecimalIfTranslation(v *validator.Validate, trans ut.Translator) error {
 	// Register main decimal_if translation
 	err := v.RegisterTranslation("decimal_if", trans, func(ut ut.Translator) error {
-		return ut.Add("decimal_if", "{0} must be a decimal with precision ≤ {1} and scale ≤ {2} when {3} equals '{4}'", false)
+		return ut.Add("decimal_if", "{0} must be a decimal with precision ≤ {1} and scale ≤ {2} when {3}", false)
 	}, func(ut ut.Translator, fe validator.FieldError) string {
 		param := fe.Param()
 		if param == "" {
@@ -88,75 +113,1345 @@ func registerDecimalIfTranslation(v *validator.Validate, trans ut.Translator) er
 			return fmt.Sprintf("%s conditional decimal validation failed", fe.Field())
 		}
 
-		// Parse parameters to get rule, field, and expected value
-		rule, field, expect, err := parseDecimalIfParam(param)
-		if err != nil {
-			return fmt.Sprintf("%s conditional decimal validation failed", fe.Field())
-		}
+		// Parse parameters to get rule and conditions
+		rule, conditions, err := parseDecimalIfParam(param)
+		if err != nil {
+			return fmt.Sprintf("%s conditional decimal validation failed", fe.Field())
+		}
+
+		// Parse decimal rule to get precision and scale
+		precision, scale := parseDecimalParams(rule)
+
+		// Special case for integer format (scale = 0)
+		if scale == 0 {
+			return fmt.Sprintf("%s must be an integer format (no decimal places) when %s",
+				fe.Field(), conditions)
+		}
+
+		// Check if we have a specific rule or using defaults
+		if rule == "" {
+			return fmt.Sprintf("%s must be a decimal with default precision and scale when %s",
+				fe.Field(), conditions)
+		}
+
+		translated, _ := ut.T("decimal_if", fe.Field(),
+			fmt.Sprintf("%d", precision),
+			fmt.Sprintf("%d", scale),
+			conditions.String())
+		return translated
+	})
+	if err != nil {
+		return fmt.Errorf("failed to register decimal_if translation: %w", err)
+	}
+
+	return nil
+}
+
+// registerWhenTranslation registers when validation translation with custom formatting
+func registerWhenTranslation(v *validator.Validate, trans ut.Translator) error {
+	err := v.RegisterTranslation("when", trans, func(ut ut.Translator) error {
+		return ut.Add("when", "{0} must satisfy '{1}' when {2}", false)
+	}, func(ut ut.Translator, fe validator.FieldError) string {
+		conditions, rules, err := parseWhenParam(fe.Param())
+		if err != nil {
+			return fmt.Sprintf("%s conditional validation failed", fe.Field())
+		}
+
+		translated, _ := ut.T("when", fe.Field(), strings.ReplaceAll(rules, ",", " "), conditions.String())
+		return translated
+	})
+	if err != nil {
+		return fmt.Errorf("failed to register when translation: %w", err)
+	}
+
+	return nil
+}
+
+// registerRequiredIfDecimalTranslations registers required_if_d* validation translations with custom formatting
+func registerRequiredIfDecimalTranslations(v *validator.Validate, trans ut.Translator) error {
+	comparisons := map[string]string{
+		"required_if_dgt":  "greater than",
+		"required_if_dgte": "greater than or equal to",
+		"required_if_dlt":  "less than",
+		"required_if_dlte": "less than or equal to",
+	}
+
+	for tag, comparison := range comparisons {
+		err := v.RegisterTranslation(tag, trans, func(ut ut.Translator) error {
+			return ut.Add(tag, "{0} is required when {1} is "+comparison+" {2}", false)
+		}, func(ut ut.Translator, fe validator.FieldError) string {
+			field, threshold, err := parseRequiredIfDecimalParam(fe.Param())
+			if err != nil {
+				return fmt.Sprintf("%s conditional required validation failed", fe.Field())
+			}
+
+			translated, _ := ut.T(tag, fe.Field(), field, threshold.String())
+			return translated
+		})
+		if err != nil {
+			return fmt.Errorf("failed to register %s translation: %w", tag, err)
+		}
+	}
+
+	return nil
+}
+
+// registerDecimalBetweenTranslation registers dbetween validation translation with custom formatting
+func registerDecimalBetweenTranslation(v *validator.Validate, trans ut.Translator) error {
+	err := v.RegisterTranslation("dbetween", trans, func(ut ut.Translator) error {
+		return ut.Add("dbetween", "{0} must be between {1} and {2}", false)
+	}, func(ut ut.Translator, fe validator.FieldError) string {
+		minValue, maxValue, ok := parseDecimalRangeParam(fe.Param())
+		if !ok {
+			return fmt.Sprintf("%s decimal range validation failed", fe.Field())
+		}
+
+		translated, _ := ut.T("dbetween", fe.Field(), minValue.String(), maxValue.String())
+		return translated
+	})
+	if err != nil {
+		return fmt.Errorf("failed to register dbetween translation: %w", err)
+	}
+
+	return nil
+}
+
+// registerCountryCodeTranslation registers country_code validation translation naming the expected code format
+func registerCountryCodeTranslation(v *validator.Validate, trans ut.Translator) error {
+	err := v.RegisterTranslation("country_code", trans, func(ut ut.Translator) error {
+		return ut.Add("country_code", "{0} must be a valid ISO 3166-1 {1} country code (e.g., {2})", false)
+	}, func(ut ut.Translator, fe validator.FieldError) string {
+		format, example := "alpha-2", "TH, US"
+		if fe.Param() == "alpha3" {
+			format, example = "alpha-3", "THA, USA"
+		}
+
+		translated, _ := ut.T("country_code", fe.Field(), format, example)
+		return translated
+	})
+	if err != nil {
+		return fmt.Errorf("failed to register country_code translation: %w", err)
+	}
+
+	return nil
+}
+
+// registerCardNumberTranslation registers card_number validation translation naming the accepted brands
+func registerCardNumberTranslation(v *validator.Validate, trans ut.Translator) error {
+	err := v.RegisterTranslation("card_number", trans, func(ut ut.Translator) error {
+		if err := ut.Add("card_number", "{0} must be a valid card number", false); err != nil {
+			return err
+		}
+		return ut.Add("card_number_brand", "{0} must be a valid {1} card number", false)
+	}, func(ut ut.Translator, fe validator.FieldError) string {
+		names := strings.Fields(fe.Param())
+		if len(names) == 0 {
+			translated, _ := ut.T("card_number", fe.Field())
+			return translated
+		}
+
+		brands := make([]string, 0, len(names))
+		for _, name := range names {
+			if brand, ok := lookupCardBrand(name); ok {
+				brands = append(brands, brand.displayName)
+			}
+		}
+
+		translated, _ := ut.T("card_number_brand", fe.Field(), strings.Join(brands, " or "))
+		return translated
+	})
+	if err != nil {
+		return fmt.Errorf("failed to register card_number translation: %w", err)
+	}
+
+	return nil
+}
+
+// registerCVVTranslation registers cvv validation translation, mentioning the card number field when given
+func registerCVVTranslation(v *validator.Validate, trans ut.Translator) error {
+	err := v.RegisterTranslation("cvv", trans, func(ut ut.Translator) error {
+		if err := ut.Add("cvv", "{0} must be a 3 or 4 digit card security code", false); err != nil {
+			return err
+		}
+		return ut.Add("cvv_card", "{0} must be a valid security code for the card in {1} (3 digits, or 4 for American Express)", false)
+	}, func(ut ut.Translator, fe validator.FieldError) string {
+		if fe.Param() == "" {
+			translated, _ := ut.T("cvv", fe.Field())
+			return translated
+		}
+
+		translated, _ := ut.T("cvv_card", fe.Field(), fe.Param())
+		return translated
+	})
+	if err != nil {
+		return fmt.Errorf("failed to register cvv translation: %w", err)
+	}
+
+	return nil
+}
+
+// registerVATTranslation registers vat validation translation listing the accepted countries
+func registerVATTranslation(v *validator.Validate, trans ut.Translator) error {
+	err := v.RegisterTranslation("vat", trans, func(ut ut.Translator) error {
+		if err := ut.Add("vat", "{0} must be a valid VAT number", false); err != nil {
+			return err
+		}
+		return ut.Add("vat_country", "{0} must be a valid VAT number for {1}", false)
+	}, func(ut ut.Translator, fe validator.FieldError) string {
+		countries := strings.Fields(fe.Param())
+		if len(countries) == 0 {
+			translated, _ := ut.T("vat", fe.Field())
+			return translated
+		}
+
+		translated, _ := ut.T("vat_country", fe.Field(), strings.Join(countries, " or "))
+		return translated
+	})
+	if err != nil {
+		return fmt.Errorf("failed to register vat translation: %w", err)
+	}
+
+	return nil
+}
+
+// registerThaiBankAccountTranslation registers thai_bank_account validation translation, mentioning the bank code field when given
+func registerThaiBankAccountTranslation(v *validator.Validate, trans ut.Translator) error {
+	err := v.RegisterTranslation("thai_bank_account", trans, func(ut ut.Translator) error {
+		if err := ut.Add("thai_bank_account", "{0} must be a valid Thai bank account number", false); err != nil {
+			return err
+		}
+		return ut.Add("thai_bank_account_bank", "{0} must be a valid account number for the bank in {1}", false)
+	}, func(ut ut.Translator, fe validator.FieldError) string {
+		if fe.Param() == "" {
+			translated, _ := ut.T("thai_bank_account", fe.Field())
+			return translated
+		}
+
+		translated, _ := ut.T("thai_bank_account_bank", fe.Field(), fe.Param())
+		return translated
+	})
+	if err != nil {
+		return fmt.Errorf("failed to register thai_bank_account translation: %w", err)
+	}
+
+	return nil
+}
+
+// registerDateBETranslation registers date_be validation translation showing the expected layout and direction
+func registerDateBETranslation(v *validator.Validate, trans ut.Translator) error {
+	err := v.RegisterTranslation("date_be", trans, func(ut ut.Translator) error {
+		if err := ut.Add("date_be", "{0} must be a valid Buddhist Era date in the format {1}", false); err != nil {
+			return err
+		}
+		if err := ut.Add("date_be_future", "{0} must be a future Buddhist Era date in the format {1}", false); err != nil {
+			return err
+		}
+		return ut.Add("date_be_past", "{0} must be a past Buddhist Era date in the format {1}", false)
+	}, func(ut ut.Translator, fe validator.FieldError) string {
+		layout, direction := parseDateBEParam(fe.Param())
+
+		key := "date_be"
+		if direction != "" {
+			key += "_" + direction
+		}
+		translated, _ := ut.T(key, fe.Field(), layout)
+		return translated
+	})
+	if err != nil {
+		return fmt.Errorf("failed to register date_be translation: %w", err)
+	}
+
+	return nil
+}
+
+// registerPhoneNoPremiumTranslation registers phone_no_premium validation translation listing the rejected number types
+func registerPhoneNoPremiumTranslation(v *validator.Validate, trans ut.Translator) error {
+	err := v.RegisterTranslation("phone_no_premium", trans, func(ut ut.Translator) error {
+		return ut.Add("phone_no_premium", "{0} must be a valid phone number that is not {1}", false)
+	}, func(ut ut.Translator, fe validator.FieldError) string {
+		types := "premium-rate or shared-cost"
+		if fe.Param() == "toll_free" {
+			types = "premium-rate, shared-cost or toll-free"
+		}
+
+		translated, _ := ut.T("phone_no_premium", fe.Field(), types)
+		return translated
+	})
+	if err != nil {
+		return fmt.Errorf("failed to register phone_no_premium translation: %w", err)
+	}
+
+	return nil
+}
+
+// registerPhoneRegionFieldTranslation registers phone_region_field validation translation naming the country field
+func registerPhoneRegionFieldTranslation(v *validator.Validate, trans ut.Translator) error {
+	err := v.RegisterTranslation("phone_region_field", trans, func(ut ut.Translator) error {
+		return ut.Add("phone_region_field", "{0} must be a valid phone number from the country in {1}", false)
+	}, func(ut ut.Translator, fe validator.FieldError) string {
+		translated, _ := ut.T("phone_region_field", fe.Field(), fe.Param())
+		return translated
+	})
+	if err != nil {
+		return fmt.Errorf("failed to register phone_region_field translation: %w", err)
+	}
+
+	return nil
+}
+
+// registerHTTPSURLTranslation registers https_url validation translation describing the nocreds and stdport options
+func registerHTTPSURLTranslation(v *validator.Validate, trans ut.Translator) error {
+	err := v.RegisterTranslation("https_url", trans, func(ut ut.Translator) error {
+		if err := ut.Add("https_url", "{0} must be a valid HTTPS URL", false); err != nil {
+			return err
+		}
+		return ut.Add("https_url_restricted", "{0} must be a valid HTTPS URL {1}", false)
+	}, func(ut ut.Translator, fe validator.FieldError) string {
+		var restrictions []string
+		for _, option := range strings.Fields(fe.Param()) {
+			switch option {
+			case "nocreds":
+				restrictions = append(restrictions, "without embedded credentials")
+			case "stdport":
+				restrictions = append(restrictions, "on the standard port 443")
+			}
+		}
+
+		if len(restrictions) == 0 {
+			translated, _ := ut.T("https_url", fe.Field())
+			return translated
+		}
+		translated, _ := ut.T("https_url_restricted", fe.Field(), strings.Join(restrictions, " and "))
+		return translated
+	})
+	if err != nil {
+		return fmt.Errorf("failed to register https_url translation: %w", err)
+	}
+
+	return nil
+}
+
+// registerDataURITranslation registers datauri validation translation describing the media type and size options,
+// overriding the go-playground default message
+func registerDataURITranslation(v *validator.Validate, trans ut.Translator) error {
+	err := v.RegisterTranslation("datauri", trans, func(ut ut.Translator) error {
+		if err := ut.Add("datauri", "{0} must be a valid data URI", true); err != nil {
+			return err
+		}
+		if err := ut.Add("datauri_type", "{0} must be a valid data URI of type {1}", false); err != nil {
+			return err
+		}
+		if err := ut.Add("datauri_max", "{0} must be a valid data URI of at most {1} bytes", false); err != nil {
+			return err
+		}
+		return ut.Add("datauri_type_max", "{0} must be a valid data URI of type {1} and at most {2} bytes", false)
+	}, func(ut ut.Translator, fe validator.FieldError) string {
+		var mediaTypes []string
+		var maxSize string
+		for _, option := range strings.Fields(fe.Param()) {
+			if value, ok := strings.CutPrefix(option, "max="); ok {
+				maxSize = value
+			} else {
+				mediaTypes = append(mediaTypes, option)
+			}
+		}
+
+		var translated string
+		switch {
+		case len(mediaTypes) > 0 && maxSize != "":
+			translated, _ = ut.T("datauri_type_max", fe.Field(), strings.Join(mediaTypes, ", "), maxSize)
+		case len(mediaTypes) > 0:
+			translated, _ = ut.T("datauri_type", fe.Field(), strings.Join(mediaTypes, ", "))
+		case maxSize != "":
+			translated, _ = ut.T("datauri_max", fe.Field(), maxSize)
+		default:
+			translated, _ = ut.T("datauri", fe.Field())
+		}
+		return translated
+	})
+	if err != nil {
+		return fmt.Errorf("failed to register datauri translation: %w", err)
+	}
+
+	return nil
+}
+
+// registerURLSchemeTranslation registers url_scheme validation translation listing the allowed schemes
+func registerURLSchemeTranslation(v *validator.Validate, trans ut.Translator) error {
+	err := v.RegisterTranslation("url_scheme", trans, func(ut ut.Translator) error {
+		return ut.Add("url_scheme", "{0} must be a valid URL using one of the schemes: {1}", false)
+	}, func(ut ut.Translator, fe validator.FieldError) string {
+		translated, _ := ut.T("url_scheme", fe.Field(), strings.Join(strings.Fields(fe.Param()), ", "))
+		return translated
+	})
+	if err != nil {
+		return fmt.Errorf("failed to register url_scheme translation: %w", err)
+	}
+
+	return nil
+}
+
+// registerURLDomainTranslation registers url_domain and url_domain_deny validation translations listing the domain patterns
+func registerURLDomainTranslation(v *validator.Validate, trans ut.Translator) error {
+	messages := map[string]string{
+		"url_domain":      "{0} must be a valid URL on one of the domains: {1}",
+		"url_domain_deny": "{0} must be a valid URL not on the domains: {1}",
+	}
+
+	for tag, message := range messages {
+		err := v.RegisterTranslation(tag, trans, func(ut ut.Translator) error {
+			return ut.Add(tag, message, false)
+		}, func(ut ut.Translator, fe validator.FieldError) string {
+			translated, _ := ut.T(fe.Tag(), fe.Field(), strings.Join(strings.Fields(fe.Param()), ", "))
+			return translated
+		})
+		if err != nil {
+			return fmt.Errorf("failed to register %s translation: %w", tag, err)
+		}
+	}
+
+	return nil
+}
+
+// registerThaiPhoneTranslation registers thai_phone validation translation naming the expected number type
+func registerThaiPhoneTranslation(v *validator.Validate, trans ut.Translator) error {
+	err := v.RegisterTranslation("thai_phone", trans, func(ut ut.Translator) error {
+		return ut.Add("thai_phone", "{0} must be a valid Thai {1} in local format (e.g., {2})", false)
+	}, func(ut ut.Translator, fe validator.FieldError) string {
+		kind, example := "phone number", "0812345678"
+		switch fe.Param() {
+		case "mobile":
+			kind = "mobile number"
+		case "landline":
+			kind, example = "landline number", "021234567"
+		}
+
+		translated, _ := ut.T("thai_phone", fe.Field(), kind, example)
+		return translated
+	})
+	if err != nil {
+		return fmt.Errorf("failed to register thai_phone translation: %w", err)
+	}
+
+	return nil
+}
+
+// registerPasswordStrengthTranslation registers password_strength validation translation describing the requirements
+// of the referenced password policy
+func registerPasswordStrengthTranslation(v *validator.Validate, trans ut.Translator, policies map[string]PasswordPolicy) error {
+	// Register password_strength translation without parameter placeholders
+	err := v.RegisterTranslation("password_strength", trans, func(ut ut.Translator) error {
+		return ut.Add("password_strength", "must contain at least 8 characters with: uppercase letter (A-Z), lowercase letter (a-z), digit (0-9), and special character", false)
+	}, func(ut ut.Translator, fe validator.FieldError) string {
+		// Build message from the policy, since special characters and lengths differ per policy
+		return fmt.Sprintf("%s %s", fe.Field(), policies[fe.Param()].describe())
+	})
+	if err != nil {
+		return fmt.Errorf("failed to register password_strength translation: %w", err)
+	}
+
+	return nil
+}
+
+// registerPasswordNotContainsTranslation registers password_not_contains validation translation naming the referenced fields
+func registerPasswordNotContainsTranslation(v *validator.Validate, trans ut.Translator) error {
+	err := v.RegisterTranslation("password_not_contains", trans, func(ut ut.Translator) error {
+		return ut.Add("password_not_contains", "{0} must not contain the value of {1}", false)
+	}, func(ut ut.Translator, fe validator.FieldError) string {
+		translated, _ := ut.T("password_not_contains", fe.Field(), strings.Join(strings.Fields(fe.Param()), " or "))
+		return translated
+	})
+	if err != nil {
+		return fmt.Errorf("failed to register password_not_contains translation: %w", err)
+	}
+
+	return nil
+}
+
+// registerPasswordNoSequencesTranslation registers password_no_sequences validation translation including the maximum run length
+func registerPasswordNoSequencesTranslation(v *validator.Validate, trans ut.Translator) error {
+	err := v.RegisterTranslation("password_no_sequences", trans, func(ut ut.Translator) error {
+		return ut.Add("password_no_sequences", "{0} must not contain more than {1} repeated or sequential characters", false)
+	}, func(ut ut.Translator, fe validator.FieldError) string {
+		maxLength := fe.Param()
+		if maxLength == "" {
+			maxLength = strconv.Itoa(defaultPasswordMaxSequence)
+		}
+		translated, _ := ut.T("password_no_sequences", fe.Field(), maxLength)
+		return translated
+	})
+	if err != nil {
+		return fmt.Errorf("failed to register password_no_sequences translation: %w", err)
+	}
+
+	return nil
+}
+
+// registerPasswordNoKeyboardWalkTranslation registers password_no_keyboard_walk validation translation including the maximum pattern length
+func registerPasswordNoKeyboardWalkTranslation(v *validator.Validate, trans ut.Translator) error {
+	err := v.RegisterTranslation("password_no_keyboard_walk", trans, func(ut ut.Translator) error {
+		return ut.Add("password_no_keyboard_walk", "{0} must not contain keyboard patterns of more than {1} keys (e.g., qwerty)", false)
+	}, func(ut ut.Translator, fe validator.FieldError) string {
+		maxLength := fe.Param()
+		if maxLength == "" {
+			maxLength = strconv.Itoa(defaultPasswordMaxKeyboardWalk)
+		}
+		translated, _ := ut.T("password_no_keyboard_walk", fe.Field(), maxLength)
+		return translated
+	})
+	if err != nil {
+		return fmt.Errorf("failed to register password_no_keyboard_walk translation: %w", err)
+	}
+
+	return nil
+}
+
+// registerPINTranslation registers pin validation translation including the allowed length
+func registerPINTranslation(v *validator.Validate, trans ut.Translator) error {
+	err := v.RegisterTranslation("pin", trans, func(ut ut.Translator) error {
+		if err := ut.Add("pin", "{0} must be a {1}-digit PIN that is not easy to guess", false); err != nil {
+			return err
+		}
+		return ut.Add("pin_range", "{0} must be a {1} to {2} digit PIN that is not easy to guess", false)
+	}, func(ut ut.Translator, fe validator.FieldError) string {
+		params, _ := parsePINParam(fe.Param())
+		if params.minLength == params.maxLength {
+			translated, _ := ut.T("pin", fe.Field(), strconv.Itoa(params.minLength))
+			return translated
+		}
+		translated, _ := ut.T("pin_range", fe.Field(), strconv.Itoa(params.minLength), strconv.Itoa(params.maxLength))
+		return translated
+	})
+	if err != nil {
+		return fmt.Errorf("failed to register pin translation: %w", err)
+	}
+
+	return nil
+}
+
+// registerOTPTranslation registers otp validation translation including the code length
+func registerOTPTranslation(v *validator.Validate, trans ut.Translator) error {
+	err := v.RegisterTranslation("otp", trans, func(ut ut.Translator) error {
+		return ut.Add("otp", "{0} must be a one-time code of {1} digits", false)
+	}, func(ut ut.Translator, fe validator.FieldError) string {
+		length := fe.Param()
+		if length == "" {
+			length = strconv.Itoa(defaultOTPLength)
+		}
+		translated, _ := ut.T("otp", fe.Field(), length)
+		return translated
+	})
+	if err != nil {
+		return fmt.Errorf("failed to register otp translation: %w", err)
+	}
+
+	return nil
+}
+
+// registerRelativeDateTranslations registers future_date and past_date validation translations, mentioning the offset when given
+func registerRelativeDateTranslations(v *validator.Validate, trans ut.Translator) error {
+	messages := map[string][2]string{
+		"future_date": {"{0} must be a future date", "{0} must be at least {1} in the future"},
+		"past_date":   {"{0} must be a past date", "{0} must be at least {1} in the past"},
+	}
+
+	for tag, message := range messages {
+		err := v.RegisterTranslation(tag, trans, func(ut ut.Translator) error {
+			if err := ut.Add(tag, message[0], false); err != nil {
+				return err
+			}
+			return ut.Add(tag+"_offset", message[1], false)
+		}, func(ut ut.Translator, fe validator.FieldError) string {
+			_, _, offset := parseDateOffsetParam(fe.Param())
+			if offset == "" || strings.HasPrefix(offset, "-") || offset == "0" {
+				translated, _ := ut.T(fe.Tag(), fe.Field())
+				return translated
+			}
+			translated, _ := ut.T(fe.Tag()+"_offset", fe.Field(), offset)
+			return translated
+		})
+		if err != nil {
+			return fmt.Errorf("failed to register %s translation: %w", tag, err)
+		}
+	}
+
+	return nil
+}
+
+// registerAgeGTETranslation registers age_gte validation translation including the minimum age
+func registerAgeGTETranslation(v *validator.Validate, trans ut.Translator) error {
+	err := v.RegisterTranslation("age_gte", trans, func(ut ut.Translator) error {
+		return ut.Add("age_gte", "{0} must be a date of birth at least {1} years ago", false)
+	}, func(ut ut.Translator, fe validator.FieldError) string {
+		years, _, _ := strings.Cut(fe.Param(), ":")
+		translated, _ := ut.T("age_gte", fe.Field(), years)
+		return translated
+	})
+	if err != nil {
+		return fmt.Errorf("failed to register age_gte translation: %w", err)
+	}
+
+	return nil
+}
+
+// registerDateFieldTranslations registers cross-field date comparison translations naming the other field without the layout
+func registerDateFieldTranslations(v *validator.Validate, trans ut.Translator) error {
+	messages := map[string]string{
+		"dtgtfield":  "{0} must be after {1}",
+		"dtgtefield": "{0} must be on or after {1}",
+		"dtltfield":  "{0} must be before {1}",
+		"dtltefield": "{0} must be on or before {1}",
+	}
+
+	for tag, message := range messages {
+		err := v.RegisterTranslation(tag, trans, func(ut ut.Translator) error {
+			return ut.Add(tag, message, false)
+		}, func(ut ut.Translator, fe validator.FieldError) string {
+			field, _, _ := strings.Cut(fe.Param(), ":")
+			translated, _ := ut.T(fe.Tag(), fe.Field(), field)
+			return translated
+		})
+		if err != nil {
+			return fmt.Errorf("failed to register %s translation: %w", tag, err)
+		}
+	}
+
+	return nil
+}
+
+// registerTimeBetweenTranslation registers time_between validation translation including the window and time zone
+func registerTimeBetweenTranslation(v *validator.Validate, trans ut.Translator) error {
+	err := v.RegisterTranslation("time_between", trans, func(ut ut.Translator) error {
+		if err := ut.Add("time_between", "{0} must be a time between {1} and {2}", false); err != nil {
+			return err
+		}
+		return ut.Add("time_between_zone", "{0} must be a time between {1} and {2} ({3})", false)
+	}, func(ut ut.Translator, fe validator.FieldError) string {
+		fields := strings.Fields(fe.Param())
+		parts := strings.Split(fields[0], ":")
+		start, end := parts[0]+":"+parts[1], parts[2]+":"+parts[3]
+		if len(fields) > 1 {
+			translated, _ := ut.T("time_between_zone", fe.Field(), start, end, fields[1])
+			return translated
+		}
+		translated, _ := ut.T("time_between", fe.Field(), start, end)
+		return translated
+	})
+	if err != nil {
+		return fmt.Errorf("failed to register time_between translation: %w", err)
+	}
+
+	return nil
+}
+
+// registerSlugTranslation registers slug validation translation, mentioning the maximum length when given
+func registerSlugTranslation(v *validator.Validate, trans ut.Translator) error {
+	err := v.RegisterTranslation("slug", trans, func(ut ut.Translator) error {
+		if err := ut.Add("slug", "{0} must be a lowercase slug of letters, digits and hyphens", false); err != nil {
+			return err
+		}
+		return ut.Add("slug_max", "{0} must be a lowercase slug of letters, digits and hyphens, at most {1} characters long", false)
+	}, func(ut ut.Translator, fe validator.FieldError) string {
+		if fe.Param() == "" {
+			translated, _ := ut.T("slug", fe.Field())
+			return translated
+		}
+		translated, _ := ut.T("slug_max", fe.Field(), fe.Param())
+		return translated
+	})
+	if err != nil {
+		return fmt.Errorf("failed to register slug translation: %w", err)
+	}
+
+	return nil
+}
+
+// registerSemverTranslation registers semver validation translation, mentioning the version range when given
+func registerSemverTranslation(v *validator.Validate, trans ut.Translator) error {
+	err := v.RegisterTranslation("semver", trans, func(ut ut.Translator) error {
+		if err := ut.Add("semver", "{0} must be a semantic version (e.g., 1.2.3)", false); err != nil {
+			return err
+		}
+		return ut.Add("semver_range", "{0} must be a semantic version matching {1}", false)
+	}, func(ut ut.Translator, fe validator.FieldError) string {
+		if fe.Param() == "" {
+			translated, _ := ut.T("semver", fe.Field())
+			return translated
+		}
+		translated, _ := ut.T("semver_range", fe.Field(), fe.Param())
+		return translated
+	})
+	if err != nil {
+		return fmt.Errorf("failed to register semver translation: %w", err)
+	}
+
+	return nil
+}
+
+// registerNanoIDTranslation registers nanoid validation translation including the ID length
+func registerNanoIDTranslation(v *validator.Validate, trans ut.Translator) error {
+	err := v.RegisterTranslation("nanoid", trans, func(ut ut.Translator) error {
+		return ut.Add("nanoid", "{0} must be a NanoID of {1} characters", false)
+	}, func(ut ut.Translator, fe validator.FieldError) string {
+		length, _, _ := parseNanoIDParam(fe.Param())
+		translated, _ := ut.T("nanoid", fe.Field(), strconv.Itoa(length))
+		return translated
+	})
+	if err != nil {
+		return fmt.Errorf("failed to register nanoid translation: %w", err)
+	}
+
+	return nil
+}
+
+// registerBase64URLTranslation registers base64url validation translation, mentioning the decoded length bounds when given
+func registerBase64URLTranslation(v *validator.Validate, trans ut.Translator) error {
+	err := v.RegisterTranslation("base64url", trans, func(ut ut.Translator) error {
+		messages := map[string]string{
+			"base64url":       "{0} must be a valid base64url string",
+			"base64url_len":   "{0} must be a base64url string of {1} bytes",
+			"base64url_min":   "{0} must be a base64url string of at least {1} bytes",
+			"base64url_max":   "{0} must be a base64url string of at most {1} bytes",
+			"base64url_range": "{0} must be a base64url string of {1} to {2} bytes",
+		}
+		for key, message := range messages {
+			if err := ut.Add(key, message, false); err != nil {
+				return err
+			}
+		}
+		return nil
+	}, func(ut ut.Translator, fe validator.FieldError) string {
+		bounds, _ := parseLengthBounds(fe.Param())
+		minText, maxText := strconv.Itoa(bounds.min), strconv.Itoa(bounds.max)
+
+		var translated string
+		switch {
+		case fe.Param() == "":
+			translated, _ = ut.T("base64url", fe.Field())
+		case bounds.min == bounds.max:
+			translated, _ = ut.T("base64url_len", fe.Field(), minText)
+		case bounds.max < 0:
+			translated, _ = ut.T("base64url_min", fe.Field(), minText)
+		case bounds.min == 0:
+			translated, _ = ut.T("base64url_max", fe.Field(), maxText)
+		default:
+			translated, _ = ut.T("base64url_range", fe.Field(), minText, maxText)
+		}
+		return translated
+	})
+	if err != nil {
+		return fmt.Errorf("failed to register base64url translation: %w", err)
+	}
+
+	return nil
+}
+
+// registerJSONStringTranslation registers json_string validation translation, mentioning the required top-level value when given
+func registerJSONStringTranslation(v *validator.Validate, trans ut.Translator) error {
+	err := v.RegisterTranslation("json_string", trans, func(ut ut.Translator) error {
+		if err := ut.Add("json_string", "{0} must be valid JSON", false); err != nil {
+			return err
+		}
+		return ut.Add("json_string_kind", "{0} must be a valid JSON {1}", false)
+	}, func(ut ut.Translator, fe validator.FieldError) string {
+		if fe.Param() == "" {
+			translated, _ := ut.T("json_string", fe.Field())
+			return translated
+		}
+		translated, _ := ut.T("json_string_kind", fe.Field(), fe.Param())
+		return translated
+	})
+	if err != nil {
+		return fmt.Errorf("failed to register json_string translation: %w", err)
+	}
+
+	return nil
+}
+
+// registerRegexTranslation registers regex validation translation, naming registered patterns in the message
+func registerRegexTranslation(v *validator.Validate, trans ut.Translator, patterns *patternRegistry) error {
+	err := v.RegisterTranslation("regex", trans, func(ut ut.Translator) error {
+		if err := ut.Add("regex", "{0} does not match the required format", false); err != nil {
+			return err
+		}
+		return ut.Add("regex_named", "{0} must be a valid {1}", false)
+	}, func(ut ut.Translator, fe validator.FieldError) string {
+		if _, ok := patterns.lookup(fe.Param()); ok {
+			translated, _ := ut.T("regex_named", fe.Field(), strings.ReplaceAll(fe.Param(), "_", " "))
+			return translated
+		}
+		translated, _ := ut.T("regex", fe.Field())
+		return translated
+	})
+	if err != nil {
+		return fmt.Errorf("failed to register regex translation: %w", err)
+	}
+
+	return nil
+}
+
+// registerExcludesCharsTranslation registers excludes_chars validation translation, naming the class of the offending character
+func registerExcludesCharsTranslation(v *validator.Validate, trans ut.Translator) error {
+	err := v.RegisterTranslation("excludes_chars", trans, func(ut ut.Translator) error {
+		if err := ut.Add("excludes_chars", "{0} must not contain {1}", false); err != nil {
+			return err
+		}
+		return ut.Add("excludes_chars_any", "{0} must not contain any of the characters {1}", false)
+	}, func(ut ut.Translator, fe validator.FieldError) string {
+		value, _ := fe.Value().(string)
+		if r, found := firstExcludedChar(value, fe.Param()); found {
+			translated, _ := ut.T("excludes_chars", fe.Field(), characterClassName(r))
+			return translated
+		}
+		translated, _ := ut.T("excludes_chars_any", fe.Field(), fe.Param())
+		return translated
+	})
+	if err != nil {
+		return fmt.Errorf("failed to register excludes_chars translation: %w", err)
+	}
+
+	return nil
+}
+
+// registerFilenameTranslation registers filename validation translation, listing the allowed extensions when given
+func registerFilenameTranslation(v *validator.Validate, trans ut.Translator) error {
+	err := v.RegisterTranslation("filename", trans, func(ut ut.Translator) error {
+		if err := ut.Add("filename", "{0} must be a valid file name", false); err != nil {
+			return err
+		}
+		return ut.Add("filename_ext", "{0} must be a valid file name ending in {1}", false)
+	}, func(ut ut.Translator, fe validator.FieldError) string {
+		extensions := filenameExtensions(fe.Param())
+		if len(extensions) == 0 {
+			translated, _ := ut.T("filename", fe.Field())
+			return translated
+		}
+		for i, extension := range extensions {
+			extensions[i] = "." + extension
+		}
+		translated, _ := ut.T("filename_ext", fe.Field(), strings.Join(extensions, " or "))
+		return translated
+	})
+	if err != nil {
+		return fmt.Errorf("failed to register filename translation: %w", err)
+	}
+
+	return nil
+}
+
+// registerMIMETypeTranslation registers mimetype validation translation, listing the allowed media types when given
+func registerMIMETypeTranslation(v *validator.Validate, trans ut.Translator) error {
+	err := v.RegisterTranslation("mimetype", trans, func(ut ut.Translator) error {
+		if err := ut.Add("mimetype", "{0} must be a valid MIME type (e.g., image/png)", false); err != nil {
+			return err
+		}
+		return ut.Add("mimetype_allowed", "{0} must be one of the MIME types {1}", false)
+	}, func(ut ut.Translator, fe validator.FieldError) string {
+		allowlist := strings.Fields(fe.Param())
+		if len(allowlist) == 0 {
+			translated, _ := ut.T("mimetype", fe.Field())
+			return translated
+		}
+		translated, _ := ut.T("mimetype_allowed", fe.Field(), strings.Join(allowlist, ", "))
+		return translated
+	})
+	if err != nil {
+		return fmt.Errorf("failed to register mimetype translation: %w", err)
+	}
+
+	return nil
+}
+
+// registerCoordinateTranslations registers latitude_str and longitude_str validation translations with the
+// allowed range and number of decimal places
+func registerCoordinateTranslations(v *validator.Validate, trans ut.Translator) error {
+	messages := map[string]string{
+		"latitude_str":  "{0} must be a latitude between -90 and 90 with at most {1} decimal places",
+		"longitude_str": "{0} must be a longitude between -180 and 180 with at most {1} decimal places",
+	}
+	for tag, message := range messages {
+		err := v.RegisterTranslation(tag, trans, func(ut ut.Translator) error {
+			return ut.Add(tag, message, false)
+		}, func(ut ut.Translator, fe validator.FieldError) string {
+			scale := fe.Param()
+			if scale == "" {
+				scale = strconv.Itoa(defaultCoordinateScale)
+			}
+			translated, _ := ut.T(fe.Tag(), fe.Field(), scale)
+			return translated
+		})
+		if err != nil {
+			return fmt.Errorf("failed to register %s translation: %w", tag, err)
+		}
+	}
+
+	return nil
+}
+
+// registerGeoJSONTranslation registers geojson validation translation, listing the allowed geometry types when given
+func registerGeoJSONTranslation(v *validator.Validate, trans ut.Translator) error {
+	err := v.RegisterTranslation("geojson", trans, func(ut ut.Translator) error {
+		if err := ut.Add("geojson", "{0} must be valid GeoJSON", false); err != nil {
+			return err
+		}
+		return ut.Add("geojson_types", "{0} must be valid GeoJSON with {1} geometries", false)
+	}, func(ut ut.Translator, fe validator.FieldError) string {
+		geometryTypes := strings.Fields(fe.Param())
+		if len(geometryTypes) == 0 {
+			translated, _ := ut.T("geojson", fe.Field())
+			return translated
+		}
+		translated, _ := ut.T("geojson_types", fe.Field(), strings.Join(geometryTypes, " or "))
+		return translated
+	})
+	if err != nil {
+		return fmt.Errorf("failed to register geojson translation: %w", err)
+	}
+
+	return nil
+}
+
+// registerIPInTranslation registers ip_in validation translation, listing the allowed networks
+func registerIPInTranslation(v *validator.Validate, trans ut.Translator) error {
+	err := v.RegisterTranslation("ip_in", trans, func(ut ut.Translator) error {
+		return ut.Add("ip_in", "{0} must be an IP address within {1}", false)
+	}, func(ut ut.Translator, fe validator.FieldError) string {
+		translated, _ := ut.T("ip_in", fe.Field(), strings.Join(strings.Fields(fe.Param()), ", "))
+		return translated
+	})
+	if err != nil {
+		return fmt.Errorf("failed to register ip_in translation: %w", err)
+	}
+
+	return nil
+}
+
+// registerHostPortTranslation registers hostport validation translation, mentioning a required port and the
+// allowed port range when given
+func registerHostPortTranslation(v *validator.Validate, trans ut.Translator) error {
+	err := v.RegisterTranslation("hostport", trans, func(ut ut.Translator) error {
+		messages := map[string]string{
+			"hostport":                "{0} must be a host with an optional port (e.g., example.com:443)",
+			"hostport_required":       "{0} must be a host and port (e.g., example.com:443)",
+			"hostport_range":          "{0} must be a host with an optional port between {1} and {2}",
+			"hostport_required_range": "{0} must be a host and port between {1} and {2}",
+		}
+		for key, message := range messages {
+			if err := ut.Add(key, message, false); err != nil {
+				return err
+			}
+		}
+		return nil
+	}, func(ut ut.Translator, fe validator.FieldError) string {
+		params, _ := parseHostPortParam(fe.Param())
+		key := "hostport"
+		if params.requirePort {
+			key += "_required"
+		}
+		if params.minPort == 1 && params.maxPort == maxPort {
+			translated, _ := ut.T(key, fe.Field())
+			return translated
+		}
+		translated, _ := ut.T(key+"_range", fe.Field(), strconv.Itoa(params.minPort), strconv.Itoa(params.maxPort))
+		return translated
+	})
+	if err != nil {
+		return fmt.Errorf("failed to register hostport translation: %w", err)
+	}
+
+	return nil
+}
+
+// registerPortRangeTranslation registers port_range validation translation with the range bounds
+func registerPortRangeTranslation(v *validator.Validate, trans ut.Translator) error {
+	err := v.RegisterTranslation("port_range", trans, func(ut ut.Translator) error {
+		return ut.Add("port_range", "{0} must be a port number from {1} to {2}", false)
+	}, func(ut ut.Translator, fe validator.FieldError) string {
+		minPort, maxPort, _ := strings.Cut(fe.Param(), ":")
+		translated, _ := ut.T("port_range", fe.Field(), minPort, maxPort)
+		return translated
+	})
+	if err != nil {
+		return fmt.Errorf("failed to register port_range translation: %w", err)
+	}
+
+	return nil
+}
+
+// registerBCP47Translation registers bcp47 validation translation, mentioning the canonical form when required
+func registerBCP47Translation(v *validator.Validate, trans ut.Translator) error {
+	err := v.RegisterTranslation("bcp47", trans, func(ut ut.Translator) error {
+		if err := ut.Add("bcp47", "{0} must be a valid BCP 47 language tag (e.g., th-TH)", false); err != nil {
+			return err
+		}
+		return ut.Add("bcp47_canonical", "{0} must be a BCP 47 language tag in canonical form (e.g., th-TH)", false)
+	}, func(ut ut.Translator, fe validator.FieldError) string {
+		if fe.Param() == "canonical" {
+			translated, _ := ut.T("bcp47_canonical", fe.Field())
+			return translated
+		}
+		translated, _ := ut.T("bcp47", fe.Field())
+		return translated
+	})
+	if err != nil {
+		return fmt.Errorf("failed to register bcp47 translation: %w", err)
+	}
+
+	return nil
+}
+
+// registerLocaleTranslation registers locale validation translation with an example in the required form
+func registerLocaleTranslation(v *validator.Validate, trans ut.Translator) error {
+	err := v.RegisterTranslation("locale", trans, func(ut ut.Translator) error {
+		return ut.Add("locale", "{0} must be a known locale identifier (e.g., {1})", false)
+	}, func(ut ut.Translator, fe validator.FieldError) string {
+		example := "th_TH"
+		if fe.Param() == "bcp47" {
+			example = "th-TH"
+		}
+		translated, _ := ut.T("locale", fe.Field(), example)
+		return translated
+	})
+	if err != nil {
+		return fmt.Errorf("failed to register locale translation: %w", err)
+	}
+
+	return nil
+}
+
+// registerGTINTranslation registers gtin validation translation, naming the accepted GTIN lengths
+func registerGTINTranslation(v *validator.Validate, trans ut.Translator) error {
+	err := v.RegisterTranslation("gtin", trans, func(ut ut.Translator) error {
+		return ut.Add("gtin", "{0} must be a valid {1} number", false)
+	}, func(ut ut.Translator, fe validator.FieldError) string {
+		lengths := strings.Fields(fe.Param())
+		if len(lengths) == 0 {
+			lengths = []string{"8", "12", "13", "14"}
+		}
+		names := make([]string, len(lengths))
+		for i, length := range lengths {
+			names[i] = "GTIN-" + length
+		}
+		translated, _ := ut.T("gtin", fe.Field(), strings.Join(names, " or "))
+		return translated
+	})
+	if err != nil {
+		return fmt.Errorf("failed to register gtin translation: %w", err)
+	}
+
+	return nil
+}
+
+// registerPassportTranslation registers passport validation translation, listing the issuing countries when
+// given as country codes
+func registerPassportTranslation(v *validator.Validate, trans ut.Translator) error {
+	err := v.RegisterTranslation("passport", trans, func(ut ut.Translator) error {
+		if err := ut.Add("passport", "{0} must be a valid passport number", false); err != nil {
+			return err
+		}
+		return ut.Add("passport_countries", "{0} must be a valid passport number issued by {1}", false)
+	}, func(ut ut.Translator, fe validator.FieldError) string {
+		countries := strings.Fields(fe.Param())
+		for _, country := range countries {
+			if !isPassportCountryParam(country) {
+				countries = nil
+				break
+			}
+		}
+		if len(countries) == 0 {
+			translated, _ := ut.T("passport", fe.Field())
+			return translated
+		}
+		translated, _ := ut.T("passport_countries", fe.Field(), strings.Join(countries, ", "))
+		return translated
+	})
+	if err != nil {
+		return fmt.Errorf("failed to register passport translation: %w", err)
+	}
+
+	return nil
+}
+
+// registerCustomTranslations registers English translations for our custom validators
+func registerCustomTranslations(v *validator.Validate, trans ut.Translator, o options) error {
+	// Register decimal translations first
+	err := registerDecimalTranslation(v, trans)
+	if err != nil {
+		return err
+	}
+
+	// Register decimal_if translation
+	err = registerDecimalIfTranslation(v, trans)
+	if err != nil {
+		return err
+	}
+
+	// Register decimal_loose translation
+	err = registerDecimalLooseTranslation(v, trans)
+	if err != nil {
+		return err
+	}
+
+	// Register when translation
+	err = registerWhenTranslation(v, trans)
+	if err != nil {
+		return err
+	}
+
+	// Register required_if_d* translations
+	err = registerRequiredIfDecimalTranslations(v, trans)
+	if err != nil {
+		return err
+	}
+
+	// Register dbetween translation
+	err = registerDecimalBetweenTranslation(v, trans)
+	if err != nil {
+		return err
+	}
+
+	// Register country_code translation
+	err = registerCountryCodeTranslation(v, trans)
+	if err != nil {
+		return err
+	}
+
+	// Register card_number translation
+	err = registerCardNumberTranslation(v, trans)
+	if err != nil {
+		return err
+	}
+
+	// Register cvv translation
+	err = registerCVVTranslation(v, trans)
+	if err != nil {
+		return err
+	}
+
+	// Register vat translation
+	err = registerVATTranslation(v, trans)
+	if err != nil {
+		return err
+	}
+
+	// Register thai_bank_account translation
+	err = registerThaiBankAccountTranslation(v, trans)
+	if err != nil {
+		return err
+	}
+
+	// Register date_be translation
+	err = registerDateBETranslation(v, trans)
+	if err != nil {
+		return err
+	}
+
+	// Register thai_phone translation
+	err = registerThaiPhoneTranslation(v, trans)
+	if err != nil {
+		return err
+	}
+
+	// Register phone_no_premium translation
+	err = registerPhoneNoPremiumTranslation(v, trans)
+	if err != nil {
+		return err
+	}
+
+	// Register phone_region_field translation
+	err = registerPhoneRegionFieldTranslation(v, trans)
+	if err != nil {
+		return err
+	}
 
-		// Parse decimal rule to get precision and scale
-		precision, scale := parseDecimalParams(rule)
+	// Register https_url translation
+	err = registerHTTPSURLTranslation(v, trans)
+	if err != nil {
+		return err
+	}
 
-		// Special case for integer format (scale = 0)
-		if scale == 0 {
-			return fmt.Sprintf("%s must be an integer format (no decimal places) when %s equals '%s'",
-				fe.Field(), field, expect)
-		}
+	// Register datauri translation
+	err = registerDataURITranslation(v, trans)
+	if err != nil {
+		return err
+	}
 
-		// Check if we have a specific rule or using defaults
-		if rule == "" {
-			return fmt.Sprintf("%s must be a decimal with default precision and scale when %s equals '%s'",
-				fe.Field(), field, expect)
-		}
+	// Register url_scheme translation
+	err = registerURLSchemeTranslation(v, trans)
+	if err != nil {
+		return err
+	}
 
-		translated, _ := ut.T("decimal_if", fe.Field(),
-			fmt.Sprintf("%d", precision),
-			fmt.Sprintf("%d", scale),
-			field, expect)
-		return translated
-	})
+	// Register url_domain and url_domain_deny translations
+	err = registerURLDomainTranslation(v, trans)
 	if err != nil {
-		return fmt.Errorf("failed to register decimal_if translation: %w", err)
+		return err
 	}
 
-	return nil
-}
+	// Register password_strength translation
+	err = registerPasswordStrengthTranslation(v, trans, o.passwordPolicies)
+	if err != nil {
+		return err
+	}
 
-// registerPasswordStrengthTranslation registers password_strength validation translation with custom formatting
-func registerPasswordStrengthTranslation(v *validator.Validate, trans ut.Translator) error {
-	// Define special characters as constant to avoid escaping issues
-	specialChars := "!@#$%^&*()_+-=[]{}|;:,.<>?"
+	// Register password_not_contains translation
+	err = registerPasswordNotContainsTranslation(v, trans)
+	if err != nil {
+		return err
+	}
 
-	// Register password_strength translation without parameter placeholders
-	err := v.RegisterTranslation("password_strength", trans, func(ut ut.Translator) error {
-		return ut.Add("password_strength", "must contain at least 8 characters with: uppercase letter (A-Z), lowercase letter (a-z), digit (0-9), and special character", false)
-	}, func(ut ut.Translator, fe validator.FieldError) string {
-		// Build message with special characters defined separately
-		return fmt.Sprintf("%s must contain at least 8 characters with: uppercase letter (A-Z), lowercase letter (a-z), digit (0-9), and special character (%s)", fe.Field(), specialChars)
-	})
+	// Register password_no_sequences translation
+	err = registerPasswordNoSequencesTranslation(v, trans)
 	if err != nil {
-		return fmt.Errorf("failed to register password_strength translation: %w", err)
+		return err
 	}
 
-	return nil
-}
+	// Register password_no_keyboard_walk translation
+	err = registerPasswordNoKeyboardWalkTranslation(v, trans)
+	if err != nil {
+		return err
+	}
 
-// registerCustomTranslations registers English translations for our custom validators
-func registerCustomTranslations(v *validator.Validate, trans ut.Translator) error {
-	// Register decimal translations first
-	err := registerDecimalTranslation(v, trans)
+	// Register pin translation
+	err = registerPINTranslation(v, trans)
 	if err != nil {
 		return err
 	}
 
-	// Register decimal_if translation
-	err = registerDecimalIfTranslation(v, trans)
+	// Register otp translation
+	err = registerOTPTranslation(v, trans)
 	if err != nil {
 		return err
 	}
 
-	// Register password_strength translation
-	err = registerPasswordStrengthTranslation(v, trans)
+	// Register future_date and past_date translations
+	err = registerRelativeDateTranslations(v, trans)
+	if err != nil {
+		return err
+	}
+
+	// Register age_gte translation
+	err = registerAgeGTETranslation(v, trans)
+	if err != nil {
+		return err
+	}
+
+	// Register cross-field date comparison translations
+	err = registerDateFieldTranslations(v, trans)
+	if err != nil {
+		return err
+	}
+
+	// Register time_between translation
+	err = registerTimeBetweenTranslation(v, trans)
+	if err != nil {
+		return err
+	}
+
+	// Register slug translation
+	err = registerSlugTranslation(v, trans)
+	if err != nil {
+		return err
+	}
+
+	// Register semver translation
+	err = registerSemverTranslation(v, trans)
+	if err != nil {
+		return err
+	}
+
+	// Register nanoid translation
+	err = registerNanoIDTranslation(v, trans)
+	if err != nil {
+		return err
+	}
+
+	// Register base64url translation
+	err = registerBase64URLTranslation(v, trans)
+	if err != nil {
+		return err
+	}
+
+	// Register json_string translation
+	err = registerJSONStringTranslation(v, trans)
+	if err != nil {
+		return err
+	}
+
+	// Register regex translation
+	err = registerRegexTranslation(v, trans, o.patterns)
+	if err != nil {
+		return err
+	}
+
+	// Register excludes_chars translation
+	err = registerExcludesCharsTranslation(v, trans)
+	if err != nil {
+		return err
+	}
+
+	// Register filename translation
+	err = registerFilenameTranslation(v, trans)
+	if err != nil {
+		return err
+	}
+
+	// Register mimetype translation
+	err = registerMIMETypeTranslation(v, trans)
+	if err != nil {
+		return err
+	}
+
+	// Coordinate validation translations
+	err = registerCoordinateTranslations(v, trans)
+	if err != nil {
+		return err
+	}
+
+	// GeoJSON validation translation
+	err = registerGeoJSONTranslation(v, trans)
+	if err != nil {
+		return err
+	}
+
+	// IP allowlist validation translation
+	err = registerIPInTranslation(v, trans)
+	if err != nil {
+		return err
+	}
+
+	// Host and port validation translation
+	err = registerHostPortTranslation(v, trans)
+	if err != nil {
+		return err
+	}
+
+	// Port range validation translation
+	err = registerPortRangeTranslation(v, trans)
+	if err != nil {
+		return err
+	}
+
+	// BCP 47 language tag validation translation
+	err = registerBCP47Translation(v, trans)
+	if err != nil {
+		return err
+	}
+
+	// Locale identifier validation translation
+	err = registerLocaleTranslation(v, trans)
+	if err != nil {
+		return err
+	}
+
+	// GTIN validation translation
+	err = registerGTINTranslation(v, trans)
+	if err != nil {
+		return err
+	}
+
+	// Passport number validation translation
+	err = registerPassportTranslation(v, trans)
 	if err != nil {
 		return err
 	}
@@ -197,9 +1492,214 @@ func registerCustomTranslations(v *validator.Validate, trans ut.Translator) erro
 			translation: "{0} must not be equal to {1}",
 			override:    false,
 		},
-		"https_url": {
-			tag:         "https_url",
-			translation: "{0} must be a valid HTTPS URL",
+		"dpos": {
+			tag:         "dpos",
+			translation: "{0} must be a positive number",
+			override:    false,
+		},
+		"dneg": {
+			tag:         "dneg",
+			translation: "{0} must be a negative number",
+			override:    false,
+		},
+		"dnonzero": {
+			tag:         "dnonzero",
+			translation: "{0} must not be zero",
+			override:    false,
+		},
+		"dgtfield": {
+			tag:         "dgtfield",
+			translation: "{0} must be greater than {1}",
+			override:    false,
+		},
+		"dgtefield": {
+			tag:         "dgtefield",
+			translation: "{0} must be greater than or equal to {1}",
+			override:    false,
+		},
+		"dltfield": {
+			tag:         "dltfield",
+			translation: "{0} must be less than {1}",
+			override:    false,
+		},
+		"dltefield": {
+			tag:         "dltefield",
+			translation: "{0} must be less than or equal to {1}",
+			override:    false,
+		},
+		"deqfield": {
+			tag:         "deqfield",
+			translation: "{0} must be equal to {1}",
+			override:    false,
+		},
+		"dsum": {
+			tag:         "dsum",
+			translation: "{0} must equal {1}",
+			override:    false,
+		},
+		"dmultipleof": {
+			tag:         "dmultipleof",
+			translation: "{0} must be a multiple of {1}",
+			override:    false,
+		},
+		"dsigits": {
+			tag:         "dsigits",
+			translation: "{0} must have at most {1} significant digits",
+			override:    false,
+		},
+		"decimal_canonical": {
+			tag:         "decimal_canonical",
+			translation: "{0} must be a decimal in canonical form (no exponent, leading zeros, or plus sign)",
+			override:    false,
+		},
+		"decimal_currency": {
+			tag:         "decimal_currency",
+			translation: "{0} must not have more decimal places than allowed for the currency in {1}",
+			override:    false,
+		},
+		"url_public": {
+			tag:         "url_public",
+			translation: "{0} must be a valid URL pointing to a public host",
+			override:    false,
+		},
+		"url_reachable": {
+			tag:         "url_reachable",
+			translation: "{0} must be a reachable URL",
+			override:    false,
+		},
+		"s3_uri": {
+			tag:         "s3_uri",
+			translation: "{0} must be a valid S3 URI (e.g., s3://bucket/key)",
+			override:    false,
+		},
+		"gcs_uri": {
+			tag:         "gcs_uri",
+			translation: "{0} must be a valid Google Cloud Storage URI (e.g., gs://bucket/object)",
+			override:    false,
+		},
+		"uri_template": {
+			tag:         "uri_template",
+			translation: "{0} must be a valid RFC 6570 URI template",
+			override:    false,
+		},
+		"url_max": {
+			tag:         "url_max",
+			translation: "{0} must be a valid URL of at most {1} bytes",
+			override:    false,
+		},
+		"url_normalized": {
+			tag:         "url_normalized",
+			translation: "{0} must be a URL in normalized form (lowercase host, no default port, no dot segments, \"/\" instead of an empty path)",
+			override:    false,
+		},
+		"fqdn_public": {
+			tag:         "fqdn_public",
+			translation: "{0} must be a public domain name under a registrable domain (e.g., shop.example.co.th)",
+			override:    false,
+		},
+		"rfc3339": {
+			tag:         "rfc3339",
+			translation: "{0} must be a valid RFC 3339 timestamp",
+			override:    false,
+		},
+		"rfc3339_utc": {
+			tag:         "rfc3339_utc",
+			translation: "{0} must be a valid RFC 3339 timestamp in UTC (ending in Z)",
+			override:    false,
+		},
+		"email_mx": {
+			tag:         "email_mx",
+			translation: "{0} must be an email address at a domain that accepts mail",
+			override:    false,
+		},
+		"email_idn": {
+			tag:         "email_idn",
+			translation: "{0} must be a valid email address",
+			override:    false,
+		},
+		"email_business": {
+			tag:         "email_business",
+			translation: "{0} must be a business email address",
+			override:    false,
+		},
+		"email_not_disposable": {
+			tag:         "email_not_disposable",
+			translation: "{0} must not be a disposable email address",
+			override:    false,
+		},
+		"ulid": {
+			tag:         "ulid",
+			translation: "{0} must be a valid ULID",
+			override:    true, // replaces the go-playground/validator translation of its built-in ulid rule
+		},
+		"ksuid": {
+			tag:         "ksuid",
+			translation: "{0} must be a valid KSUID",
+			override:    false,
+		},
+		"snowflake": {
+			tag:         "snowflake",
+			translation: "{0} must be a valid Snowflake ID",
+			override:    false,
+		},
+		"yaml_string": {
+			tag:         "yaml_string",
+			translation: "{0} must be valid YAML",
+			override:    false,
+		},
+		"no_emoji": {
+			tag:         "no_emoji",
+			translation: "{0} must not contain emoji",
+			override:    false,
+		},
+		"clean_text": {
+			tag:         "clean_text",
+			translation: "{0} must not contain inappropriate language",
+			override:    false,
+		},
+		"no_html": {
+			tag:         "no_html",
+			translation: "{0} must be plain text without HTML",
+			override:    false,
+		},
+		"port": {
+			tag:         "port",
+			translation: "{0} must be a port number from 1 to 65535",
+			override:    false,
+		},
+		"ean13": {
+			tag:         "ean13",
+			translation: "{0} must be a valid EAN-13 barcode number",
+			override:    false,
+		},
+		"upc": {
+			tag:         "upc",
+			translation: "{0} must be a valid UPC-A barcode number",
+			override:    false,
+		},
+		"vin": {
+			tag:         "vin",
+			translation: "{0} must be a valid 17-character vehicle identification number",
+			override:    false,
+		},
+		"mrz": {
+			tag:         "mrz",
+			translation: "{0} must be a valid passport machine readable zone",
+			override:    false,
+		},
+		"password_not_common": {
+			tag:         "password_not_common",
+			translation: "{0} must not be a commonly used password",
+			override:    false,
+		},
+		"password_not_breached": {
+			tag:         "password_not_breached",
+			translation: "{0} has appeared in a data breach and must not be used",
+			override:    false,
+		},
+		"password_not_reused": {
+			tag:         "password_not_reused",
+			translation: "{0} must not match a recently used password",
 			override:    false,
 		},
 		"mobile_e164": {
@@ -207,6 +1707,71 @@ func registerCustomTranslations(v *validator.Validate, trans ut.Translator) erro
 			translation: "{0} must be a valid mobile number in E.164 format (e.g., +66812345678)",
 			override:    false,
 		},
+		"mobile_e164_possible": {
+			tag:         "mobile_e164_possible",
+			translation: "{0} must be a possible mobile number in E.164 format (e.g., +66812345678)",
+			override:    false,
+		},
+		"landline_e164": {
+			tag:         "landline_e164",
+			translation: "{0} must be a valid landline number in E.164 format (e.g., +6621234567)",
+			override:    false,
+		},
+		"phone_e164": {
+			tag:         "phone_e164",
+			translation: "{0} must be a valid phone number in E.164 format (e.g., +6621234567)",
+			override:    false,
+		},
+		"card_expiry": {
+			tag:         "card_expiry",
+			translation: "{0} must be a valid card expiry date that has not passed",
+			override:    false,
+		},
+		"aba_routing": {
+			tag:         "aba_routing",
+			translation: "{0} must be a valid 9-digit ABA routing number",
+			override:    false,
+		},
+		"btc_address": {
+			tag:         "btc_address",
+			translation: "{0} must be a valid Bitcoin address",
+			override:    false,
+		},
+		"eth_address": {
+			tag:         "eth_address",
+			translation: "{0} must be a valid Ethereum address",
+			override:    false,
+		},
+		"thai_id": {
+			tag:         "thai_id",
+			translation: "{0} must be a valid 13-digit Thai national ID number",
+			override:    false,
+		},
+		"thai_tax_id": {
+			tag:         "thai_tax_id",
+			translation: "{0} must be a valid 13-digit Thai taxpayer identification number",
+			override:    false,
+		},
+		"thai_bank_code": {
+			tag:         "thai_bank_code",
+			translation: "{0} must be a valid 3-digit Thai bank code",
+			override:    false,
+		},
+		"thai_plate": {
+			tag:         "thai_plate",
+			translation: "{0} must be a valid Thai license plate (e.g., 1กข 1234 กรุงเทพมหานคร)",
+			override:    false,
+		},
+		"thai_text": {
+			tag:         "thai_text",
+			translation: "{0} must contain only Thai characters",
+			override:    false,
+		},
+		"thai_text_only": {
+			tag:         "thai_text_only",
+			translation: "{0} must contain only Thai characters",
+			override:    false,
+		},
 		"iso4217": {
 			tag:         "iso4217",
 			translation: "{0} must be a valid ISO 4217 currency code (e.g., THB, USD, EUR)",
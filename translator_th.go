@@ -0,0 +1,39 @@
+package xvalidator
+
+import (
+	"fmt"
+
+	ut "github.com/go-playground/universal-translator"
+	"github.com/go-playground/validator/v10"
+)
+
+// RegisterThaiTranslations registers Thai-language messages for the Thailand-specific validators.
+// Use it with a Thai translator, e.g. one created from github.com/go-playground/locales/th
+// and set up with github.com/go-playground/validator/v10/translations/th for the built-in tags.
+// NewValidator keeps using the English translator; this function is for callers managing their own.
+func RegisterThaiTranslations(v *validator.Validate, trans ut.Translator) error {
+	translations := map[string]string{
+		"thai_id":           "{0} ต้องเป็นเลขประจำตัวประชาชน 13 หลักที่ถูกต้อง",
+		"thai_tax_id":       "{0} ต้องเป็นเลขประจำตัวผู้เสียภาษีอากร 13 หลักที่ถูกต้อง",
+		"thai_bank_code":    "{0} ต้องเป็นรหัสธนาคาร 3 หลักที่ถูกต้อง",
+		"thai_bank_account": "{0} ต้องเป็นเลขที่บัญชีธนาคารที่ถูกต้อง",
+		"thai_plate":        "{0} ต้องเป็นหมายเลขทะเบียนรถที่ถูกต้อง",
+		"thai_text":         "{0} ต้องเป็นภาษาไทยเท่านั้น",
+		"thai_text_only":    "{0} ต้องเป็นภาษาไทยเท่านั้น",
+		"date_be":           "{0} ต้องเป็นวันที่ในรูปแบบพุทธศักราชที่ถูกต้อง",
+		"thai_phone":        "{0} ต้องเป็นหมายเลขโทรศัพท์ไทยที่ถูกต้อง",
+	}
+
+	for tag, translation := range translations {
+		err := v.RegisterTranslation(tag, trans, func(ut ut.Translator) error {
+			return ut.Add(tag, translation, false)
+		}, func(ut ut.Translator, fe validator.FieldError) string {
+			translated, _ := ut.T(tag, fe.Field())
+			return translated
+		})
+		if err != nil {
+			return fmt.Errorf("failed to register Thai translation for %s: %w", tag, err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,34 @@
+package xvalidator
+
+import (
+	"testing"
+
+	"github.com/go-playground/locales/th"
+	ut "github.com/go-playground/universal-translator"
+	"github.com/go-playground/validator/v10"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRegisterThaiTranslations(t *testing.T) {
+	v := validator.New()
+	RegisterThaiValidators(v)
+
+	thai := th.New()
+	uni := ut.New(thai, thai)
+	trans, found := uni.GetTranslator("th")
+	require.True(t, found)
+
+	require.NoError(t, RegisterThaiTranslations(v, trans))
+
+	type Citizen struct {
+		IDCard string `validate:"thai_id"`
+	}
+
+	err := v.Struct(Citizen{IDCard: "1103700166115"})
+	require.Error(t, err)
+
+	validationErrors, ok := err.(validator.ValidationErrors)
+	require.True(t, ok)
+	assert.Equal(t, "IDCard ต้องเป็นเลขประจำตัวประชาชน 13 หลักที่ถูกต้อง", validationErrors[0].Translate(trans))
+}
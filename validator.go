@@ -1,7 +1,11 @@
 package xvalidator
 
 import (
+	"context"
+	"errors"
+	"fmt"
 	"reflect"
+	"regexp"
 	"strings"
 
 	ut "github.com/go-playground/universal-translator"
@@ -12,10 +16,20 @@ import (
 type Validator struct {
 	validate   *validator.Validate
 	translator ut.Translator
+	modifiers  map[string]ModifierFunc
+	patterns   *patternRegistry
+	passports  *passportRegistry
 }
 
 // NewValidator creates a new validator instance with all custom rules and English translator registered.
-func NewValidator() (*Validator, error) {
+// Options such as WithClock adjust the behavior of the registered rules.
+func NewValidator(opts ...Option) (*Validator, error) {
+	o := defaultOptions()
+	for _, opt := range opts {
+		opt(&o)
+	}
+	o.registerDisabled = true
+
 	v := validator.New()
 
 	// Register JSON tag name function for better field naming
@@ -23,12 +37,26 @@ func NewValidator() (*Validator, error) {
 
 	// Register all custom validators
 	RegisterDecimalValidators(v)
-	RegisterURLValidators(v)
+	RegisterConditionalValidators(v)
+	registerPaymentValidators(v, o)
+	RegisterLocaleValidators(v)
+	registerThaiValidators(v, o)
+	registerURLValidators(v, o)
 	RegisterPhoneValidators(v)
-	RegisterPasswordValidators(v)
+	registerPasswordValidators(v, o)
+	registerDateValidators(v, o)
+	registerEmailValidators(v, o)
+	registerIdentifierValidators(v, o)
+	registerContentValidators(v, o)
+	registerTextValidators(v, o)
+	RegisterUploadValidators(v)
+	RegisterGeoValidators(v)
+	RegisterNetworkValidators(v)
+	RegisterProductValidators(v)
+	registerDocumentValidators(v, o)
 
 	// Setup English translator
-	trans, err := setupTranslator(v)
+	trans, err := setupTranslator(v, o)
 	if err != nil {
 		return nil, err
 	}
@@ -36,6 +64,9 @@ func NewValidator() (*Validator, error) {
 	return &Validator{
 		validate:   v,
 		translator: trans,
+		modifiers:  defaultModifiers(),
+		patterns:   o.patterns,
+		passports:  o.passports,
 	}, nil
 }
 
@@ -49,27 +80,76 @@ func (v *Validator) GetValidator() *validator.Validate {
 	return v.validate
 }
 
+// RegisterPattern compiles pattern (RE2 syntax) and registers it under name for the regex rule, so tags can
+// refer to it as regex=name instead of repeating the expression. Registering a name again replaces its pattern.
+// It is safe to call concurrently with validation.
+func (v *Validator) RegisterPattern(name, pattern string) error {
+	if name == "" {
+		return errors.New("xvalidator: pattern name must not be empty")
+	}
+	regex, err := regexp.Compile(pattern)
+	if err != nil {
+		return fmt.Errorf("xvalidator: invalid pattern %q: %w", name, err)
+	}
+	v.patterns.patterns.Store(name, regex)
+	return nil
+}
+
+// RegisterPassportFormat registers the passport number format of country, an ISO 3166-1 alpha-2 code such as
+// "LA", for the passport rule, replacing its built-in format if any. pattern (RE2 syntax) must match the whole
+// passport number. It is safe to call concurrently with validation.
+func (v *Validator) RegisterPassportFormat(country, pattern string) error {
+	if _, ok := CountryAlpha3(country); !ok {
+		return fmt.Errorf("xvalidator: unknown country code %q", country)
+	}
+	regex, err := regexp.Compile(anchoredPattern(pattern))
+	if err != nil {
+		return fmt.Errorf("xvalidator: invalid passport format for %s: %w", country, err)
+	}
+	v.passports.formats.Store(country, regex)
+	return nil
+}
+
 // Validate validates a struct and returns raw validation errors without translation.
+// Misconfigured tags are reported as *ConfigError.
 // For user-friendly error messages, use StructTranslated instead.
-func (v *Validator) Validate(i any) error {
-	return v.validate.Struct(i)
+func (v *Validator) Validate(i any) (err error) {
+	return v.StructCtx(context.Background(), i)
 }
 
 // Struct validates a struct and returns raw validation errors without translation.
 // This method is an alias for Validate for consistency with other validator methods.
-func (v *Validator) Struct(i any) error {
-	return v.validate.Struct(i)
+func (v *Validator) Struct(i any) (err error) {
+	return v.StructCtx(context.Background(), i)
+}
+
+// StructCtx validates a struct like Struct, passing ctx to context-aware rules such as url_public=resolve.
+func (v *Validator) StructCtx(ctx context.Context, i any) (err error) {
+	defer RecoverConfigError(&err)
+	return v.validate.StructCtx(ctx, i)
 }
 
 // Var validates a single variable using the provided validation tag and returns raw errors.
 // For user-friendly error messages, use VarTranslated instead.
-func (v *Validator) Var(field any, tag string) error {
-	return v.validate.Var(field, tag)
+func (v *Validator) Var(field any, tag string) (err error) {
+	return v.VarCtx(context.Background(), field, tag)
+}
+
+// VarCtx validates a single variable like Var, passing ctx to context-aware rules.
+func (v *Validator) VarCtx(ctx context.Context, field any, tag string) (err error) {
+	defer RecoverConfigError(&err)
+	return v.validate.VarCtx(ctx, field, tag)
 }
 
 // StructTranslated validates a struct based on tags and returns user-friendly translated error messages.
-func (v *Validator) StructTranslated(s any) error {
-	err := v.validate.Struct(s)
+func (v *Validator) StructTranslated(s any) (err error) {
+	return v.StructTranslatedCtx(context.Background(), s)
+}
+
+// StructTranslatedCtx validates a struct like StructTranslated, passing ctx to context-aware rules.
+func (v *Validator) StructTranslatedCtx(ctx context.Context, s any) (err error) {
+	defer RecoverConfigError(&err)
+	err = v.validate.StructCtx(ctx, s)
 	if err != nil {
 		if validationErrors, ok := err.(validator.ValidationErrors); ok {
 			return formatTranslatedErrors(validationErrors, v.translator)
@@ -79,8 +159,14 @@ func (v *Validator) StructTranslated(s any) error {
 }
 
 // VarTranslated validates a single variable using the provided validation tag and returns user-friendly translated error messages.
-func (v *Validator) VarTranslated(field any, tag string) error {
-	err := v.validate.Var(field, tag)
+func (v *Validator) VarTranslated(field any, tag string) (err error) {
+	return v.VarTranslatedCtx(context.Background(), field, tag)
+}
+
+// VarTranslatedCtx validates a single variable like VarTranslated, passing ctx to context-aware rules.
+func (v *Validator) VarTranslatedCtx(ctx context.Context, field any, tag string) (err error) {
+	defer RecoverConfigError(&err)
+	err = v.validate.VarCtx(ctx, field, tag)
 	if err != nil {
 		if validationErrors, ok := err.(validator.ValidationErrors); ok {
 			return formatTranslatedErrors(validationErrors, v.translator)
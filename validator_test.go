@@ -1,6 +1,7 @@
 package xvalidator
 
 import (
+	"context"
 	"fmt"
 	"reflect"
 	"testing"
@@ -534,3 +535,72 @@ func TestValidator_ErrorTypes(t *testing.T) {
 		assert.Equal(t, err.Error(), translatedErr.Error())
 	})
 }
+
+func TestValidator_ConfigError(t *testing.T) {
+	v, err := NewValidator()
+	require.NoError(t, err)
+
+	type Misconfigured struct {
+		Amount string `validate:"decimal_if=2@Missing=x"`
+	}
+
+	tests := []struct {
+		name string
+		fn   func() error
+	}{
+		{"Validate", func() error { return v.Validate(Misconfigured{Amount: "1"}) }},
+		{"Struct", func() error { return v.Struct(Misconfigured{Amount: "1"}) }},
+		{"StructTranslated", func() error { return v.StructTranslated(Misconfigured{Amount: "1"}) }},
+		{"Var", func() error { return v.Var("1", "decimal_if=2@Mode=x") }},
+		{"VarTranslated", func() error { return v.VarTranslated("1", "decimal_if=2@Mode=x") }},
+		{"StructCtx", func() error { return v.StructCtx(context.Background(), Misconfigured{Amount: "1"}) }},
+		{"StructTranslatedCtx", func() error { return v.StructTranslatedCtx(context.Background(), Misconfigured{Amount: "1"}) }},
+		{"VarCtx", func() error { return v.VarCtx(context.Background(), "1", "decimal_if=2@Mode=x") }},
+		{"VarTranslatedCtx", func() error { return v.VarTranslatedCtx(context.Background(), "1", "decimal_if=2@Mode=x") }},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.fn()
+			require.Error(t, err)
+
+			var configErr *ConfigError
+			require.ErrorAs(t, err, &configErr)
+			assert.Equal(t, "decimal_if", configErr.Tag)
+			assert.Contains(t, err.Error(), "condition references a field that does not exist")
+
+			_, isValidationErrors := err.(validator.ValidationErrors)
+			assert.False(t, isValidationErrors)
+		})
+	}
+}
+
+func TestValidator_ContextMethods(t *testing.T) {
+	resolver := fakeResolver{"hooks.example.com": {"10.0.0.1"}}
+	v, err := NewValidator(WithResolver(resolver))
+	require.NoError(t, err)
+
+	type Webhook struct {
+		URL string `json:"url" validate:"url_public=resolve"`
+	}
+
+	ctx := context.Background()
+	invalid := Webhook{URL: "https://hooks.example.com/cb"}
+
+	err = v.StructCtx(ctx, invalid)
+	require.Error(t, err)
+	_, isValidationErrors := err.(validator.ValidationErrors)
+	assert.True(t, isValidationErrors)
+
+	err = v.StructTranslatedCtx(ctx, invalid)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "url must be a valid URL pointing to a public host")
+
+	assert.Error(t, v.VarCtx(ctx, invalid.URL, "url_public=resolve"))
+
+	err = v.VarTranslatedCtx(ctx, invalid.URL, "url_public=resolve")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "must be a valid URL pointing to a public host")
+
+	assert.NoError(t, v.StructCtx(ctx, Webhook{URL: "https://8.8.8.8/cb"}))
+}
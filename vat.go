@@ -0,0 +1,203 @@
+package xvalidator
+
+import (
+	"regexp"
+	"strings"
+)
+
+// vatFormat describes the VAT registration number format of a country.
+type vatFormat struct {
+	// prefix is the country prefix the number must start with; empty when numbers are written without one.
+	prefix string
+	// pattern matches the number after the prefix.
+	pattern func() *regexp.Regexp
+	// check verifies the check digits of the number after the prefix; nil when no public algorithm exists.
+	check func(string) bool
+}
+
+// vatFormats maps supported country codes to their VAT number formats.
+// EU member states use their VIES prefix (EL for Greece); GR is accepted as an alias of EL.
+var vatFormats = map[string]vatFormat{
+	"AT": {prefix: "AT", pattern: lazyRegexCompile(`^U[0-9]{8}$`)},
+	"BE": {prefix: "BE", pattern: lazyRegexCompile(`^[01][0-9]{9}$`), check: vatCheckBE},
+	"BG": {prefix: "BG", pattern: lazyRegexCompile(`^[0-9]{9,10}$`)},
+	"CY": {prefix: "CY", pattern: lazyRegexCompile(`^[0-9]{8}[A-Z]$`)},
+	"CZ": {prefix: "CZ", pattern: lazyRegexCompile(`^[0-9]{8,10}$`)},
+	"DE": {prefix: "DE", pattern: lazyRegexCompile(`^[0-9]{9}$`), check: vatCheckDE},
+	"DK": {prefix: "DK", pattern: lazyRegexCompile(`^[0-9]{8}$`), check: vatCheckDK},
+	"EE": {prefix: "EE", pattern: lazyRegexCompile(`^[0-9]{9}$`)},
+	"EL": {prefix: "EL", pattern: lazyRegexCompile(`^[0-9]{9}$`)},
+	"ES": {prefix: "ES", pattern: lazyRegexCompile(`^[0-9A-Z][0-9]{7}[0-9A-Z]$`)},
+	"FI": {prefix: "FI", pattern: lazyRegexCompile(`^[0-9]{8}$`), check: vatCheckFI},
+	"FR": {prefix: "FR", pattern: lazyRegexCompile(`^[0-9A-HJ-NP-Z]{2}[0-9]{9}$`), check: vatCheckFR},
+	"HR": {prefix: "HR", pattern: lazyRegexCompile(`^[0-9]{11}$`)},
+	"HU": {prefix: "HU", pattern: lazyRegexCompile(`^[0-9]{8}$`)},
+	"IE": {prefix: "IE", pattern: lazyRegexCompile(`^([0-9]{7}[A-W][A-I]?|[0-9][A-Z+*][0-9]{5}[A-W])$`)},
+	"IT": {prefix: "IT", pattern: lazyRegexCompile(`^[0-9]{11}$`), check: luhnValid},
+	"LT": {prefix: "LT", pattern: lazyRegexCompile(`^([0-9]{9}|[0-9]{12})$`)},
+	"LU": {prefix: "LU", pattern: lazyRegexCompile(`^[0-9]{8}$`)},
+	"LV": {prefix: "LV", pattern: lazyRegexCompile(`^[0-9]{11}$`)},
+	"MT": {prefix: "MT", pattern: lazyRegexCompile(`^[0-9]{8}$`)},
+	"NL": {prefix: "NL", pattern: lazyRegexCompile(`^[0-9]{9}B[0-9]{2}$`), check: vatCheckNL},
+	"PL": {prefix: "PL", pattern: lazyRegexCompile(`^[0-9]{10}$`), check: vatCheckPL},
+	"PT": {prefix: "PT", pattern: lazyRegexCompile(`^[0-9]{9}$`), check: vatCheckPT},
+	"RO": {prefix: "RO", pattern: lazyRegexCompile(`^[0-9]{2,10}$`)},
+	"SE": {prefix: "SE", pattern: lazyRegexCompile(`^[0-9]{10}01$`), check: vatCheckSE},
+	"SI": {prefix: "SI", pattern: lazyRegexCompile(`^[0-9]{8}$`)},
+	"SK": {prefix: "SK", pattern: lazyRegexCompile(`^[0-9]{10}$`)},
+	"GB": {prefix: "GB", pattern: lazyRegexCompile(`^([0-9]{9}|[0-9]{12}|GD[0-4][0-9]{2}|HA[5-9][0-9]{2})$`), check: vatCheckGB},
+	"TH": {pattern: lazyRegexCompile(`^[0-9]{13}$`), check: thaiIDChecksumValid},
+}
+
+// vatEUCountries lists the EU member state codes matched by the "EU" group parameter.
+var vatEUCountries = []string{
+	"AT", "BE", "BG", "CY", "CZ", "DE", "DK", "EE", "EL", "ES", "FI", "FR", "HR", "HU",
+	"IE", "IT", "LT", "LU", "LV", "MT", "NL", "PL", "PT", "RO", "SE", "SI", "SK",
+}
+
+// lookupVATFormat returns the VAT format for a country code, resolving the GR alias.
+func lookupVATFormat(country string) (vatFormat, bool) {
+	if country == "GR" {
+		country = "EL"
+	}
+	format, ok := vatFormats[country]
+	return format, ok
+}
+
+// vatNumberValid reports whether number is a valid VAT number for the given country format.
+func vatNumberValid(number string, format vatFormat) bool {
+	if !strings.HasPrefix(number, format.prefix) {
+		return false
+	}
+	body := number[len(format.prefix):]
+	if !format.pattern().MatchString(body) {
+		return false
+	}
+	return format.check == nil || format.check(body)
+}
+
+// isASCIIDigits reports whether s is non-empty and consists of ASCII digits only.
+func isASCIIDigits(s string) bool {
+	if s == "" {
+		return false
+	}
+	for i := 0; i < len(s); i++ {
+		if s[i] < '0' || s[i] > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+// digitsValue returns the numeric value of a string of ASCII digits.
+func digitsValue(s string) int {
+	n := 0
+	for i := 0; i < len(s); i++ {
+		n = n*10 + int(s[i]-'0')
+	}
+	return n
+}
+
+// weightedDigitSum returns the sum of the leading digits of s multiplied by the given weights.
+func weightedDigitSum(s string, weights []int) int {
+	sum := 0
+	for i, w := range weights {
+		sum += int(s[i]-'0') * w
+	}
+	return sum
+}
+
+// vatCheckBE verifies the Belgian mod 97 check: the last two digits equal 97 - (first 8 digits mod 97).
+func vatCheckBE(s string) bool {
+	return 97-digitsValue(s[:8])%97 == digitsValue(s[8:])
+}
+
+// vatCheckDE verifies the German ISO 7064 MOD 11,10 check digit.
+func vatCheckDE(s string) bool {
+	product := 10
+	for i := 0; i < 8; i++ {
+		sum := (int(s[i]-'0') + product) % 10
+		if sum == 0 {
+			sum = 10
+		}
+		product = (2 * sum) % 11
+	}
+	check := 11 - product
+	if check == 10 {
+		check = 0
+	}
+	return check == int(s[8]-'0')
+}
+
+// vatCheckDK verifies the Danish weighted mod 11 check.
+func vatCheckDK(s string) bool {
+	return weightedDigitSum(s, []int{2, 7, 6, 5, 4, 3, 2, 1})%11 == 0
+}
+
+// vatCheckFI verifies the Finnish weighted mod 11 check digit.
+func vatCheckFI(s string) bool {
+	remainder := weightedDigitSum(s, []int{7, 9, 10, 5, 8, 4, 2}) % 11
+	switch remainder {
+	case 0:
+		return s[7] == '0'
+	case 1:
+		return false
+	default:
+		return 11-remainder == int(s[7]-'0')
+	}
+}
+
+// vatCheckFR verifies the French numeric key against the SIREN; alphanumeric keys have no public check.
+func vatCheckFR(s string) bool {
+	if s[0] < '0' || s[0] > '9' || s[1] < '0' || s[1] > '9' {
+		return true
+	}
+	return digitsValue(s[:2]) == (12+3*(digitsValue(s[2:])%97))%97
+}
+
+// vatCheckNL verifies Dutch numbers using either the mod 11 check of legal entities
+// or the ISO 7064 mod 97 check of the full "NL" number issued to sole proprietors.
+func vatCheckNL(s string) bool {
+	if weightedDigitSum(s, []int{9, 8, 7, 6, 5, 4, 3, 2})%11 == int(s[8]-'0') {
+		return true
+	}
+
+	// "NL" and "B" become 2321 and 11 in ISO 7064 letter-to-number conversion
+	remainder := 2321 % 97
+	for _, part := range []string{s[:9], "11", s[10:]} {
+		for i := 0; i < len(part); i++ {
+			remainder = (remainder*10 + int(part[i]-'0')) % 97
+		}
+	}
+	return remainder == 1
+}
+
+// vatCheckPL verifies the Polish weighted mod 11 check digit.
+func vatCheckPL(s string) bool {
+	check := weightedDigitSum(s, []int{6, 5, 7, 2, 3, 4, 5, 6, 7}) % 11
+	return check != 10 && check == int(s[9]-'0')
+}
+
+// vatCheckPT verifies the Portuguese weighted mod 11 check digit.
+func vatCheckPT(s string) bool {
+	check := 11 - weightedDigitSum(s, []int{9, 8, 7, 6, 5, 4, 3, 2})%11
+	if check > 9 {
+		check = 0
+	}
+	return check == int(s[8]-'0')
+}
+
+// vatCheckSE verifies the Luhn check of the Swedish organisation number embedded in the VAT number.
+func vatCheckSE(s string) bool {
+	return luhnValid(s[:10])
+}
+
+// vatCheckGB verifies the UK mod 97 (or mod 97 - 55) check of standard and branch numbers.
+// Government department (GD) and health authority (HA) numbers carry no check digits.
+func vatCheckGB(s string) bool {
+	if s[0] == 'G' || s[0] == 'H' {
+		return true
+	}
+	total := weightedDigitSum(s, []int{8, 7, 6, 5, 4, 3, 2}) + digitsValue(s[7:9])
+	return total%97 == 0 || (total+55)%97 == 0
+}
@@ -0,0 +1,302 @@
+// Package xvupload validates multipart file uploads with struct tags, so *multipart.FileHeader fields get the
+// same tag-driven treatment as fields checked by xvalidator.
+//
+// Fields of type *multipart.FileHeader or []*multipart.FileHeader are checked against their `file` tag:
+//
+//	type AvatarForm struct {
+//	    Avatar *multipart.FileHeader `form:"avatar" file:"required,maxsize=5MB,mime=image/png image/jpeg"`
+//	}
+//
+// Available rules, separated by commas:
+//   - required - a file must be present (for slices, at least one)
+//   - maxsize=N, minsize=N - file size bounds, such as 500KB or 5MB (1KB = 1024 bytes)
+//   - mime=type/subtype ... - allowed MIME types, detected from the file content rather than trusted from the
+//     client; "image/*" allows every image type
+//   - ext=png jpg ... - allowed file name extensions, compared case-insensitively
+//   - maxfiles=N - the most files a slice field may hold
+package xvupload
+
+import (
+	"fmt"
+	"mime/multipart"
+	"path"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/gabriel-vasile/mimetype"
+	"github.com/hotfixfirst/go-xvalidator"
+)
+
+// FieldError describes an upload field that failed a rule of its `file` tag.
+type FieldError struct {
+	Field   string // form, then json, tag name of the field, or its Go name
+	Rule    string // the failed rule, such as "maxsize"
+	Param   string // the rule parameter, such as "5MB"
+	Message string // an English message, such as "avatar must be at most 5MB"
+}
+
+// ValidationErrors lists the upload fields that failed validation.
+type ValidationErrors []FieldError
+
+// Error implements the error interface, joining the messages like xvalidator's translated errors.
+func (e ValidationErrors) Error() string {
+	messages := make([]string, len(e))
+	for i, fieldErr := range e {
+		messages[i] = fieldErr.Message
+	}
+	return strings.Join(messages, "; ")
+}
+
+var (
+	fileHeaderType      = reflect.TypeOf((*multipart.FileHeader)(nil))
+	fileHeaderSliceType = reflect.TypeOf([]*multipart.FileHeader(nil))
+)
+
+// rule is a parsed rule of a `file` tag.
+type rule struct {
+	name, param string
+	size        int64    // maxsize, minsize
+	count       int      // maxfiles
+	values      []string // mime, ext
+}
+
+// sizeUnits maps size suffixes to bytes, longest first so "KB" is tried before "B".
+var sizeUnits = []struct {
+	suffix string
+	bytes  int64
+}{
+	{"KIB", 1 << 10}, {"MIB", 1 << 20}, {"GIB", 1 << 30},
+	{"KB", 1 << 10}, {"MB", 1 << 20}, {"GB", 1 << 30},
+	{"B", 1},
+}
+
+// parseSize parses a size such as "512", "500KB" or "1.5MB" into bytes.
+func parseSize(param string) (int64, bool) {
+	number, multiplier := strings.ToUpper(strings.TrimSpace(param)), int64(1)
+	for _, unit := range sizeUnits {
+		if strings.HasSuffix(number, unit.suffix) {
+			number, multiplier = strings.TrimSpace(strings.TrimSuffix(number, unit.suffix)), unit.bytes
+			break
+		}
+	}
+	value, err := strconv.ParseFloat(number, 64)
+	if err != nil || value < 0 {
+		return 0, false
+	}
+	return int64(value * float64(multiplier)), true
+}
+
+// parseTag parses a `file` tag into rules, reporting malformed rules as *xvalidator.ConfigError.
+func parseTag(tag string) ([]rule, error) {
+	var rules []rule
+	for _, part := range strings.Split(tag, ",") {
+		name, param, _ := strings.Cut(strings.TrimSpace(part), "=")
+		r := rule{name: name, param: param}
+
+		ok := true
+		switch name {
+		case "required":
+		case "maxsize", "minsize":
+			r.size, ok = parseSize(param)
+		case "maxfiles":
+			var err error
+			r.count, err = strconv.Atoi(param)
+			ok = err == nil && r.count > 0
+		case "mime":
+			r.values = strings.Fields(strings.ToLower(param))
+			ok = len(r.values) > 0
+		case "ext":
+			for _, extension := range strings.Fields(strings.ToLower(param)) {
+				r.values = append(r.values, "."+strings.TrimPrefix(extension, "."))
+			}
+			ok = len(r.values) > 0
+		default:
+			return nil, &xvalidator.ConfigError{Tag: "file", Param: part, Reason: "unknown upload rule"}
+		}
+		if !ok {
+			return nil, &xvalidator.ConfigError{Tag: "file", Param: part, Reason: "invalid parameter"}
+		}
+		rules = append(rules, r)
+	}
+	return rules, nil
+}
+
+// Validate checks the *multipart.FileHeader and []*multipart.FileHeader fields of the struct s, or the struct
+// s points to, against their `file` tags. Nested structs are checked too. It returns ValidationErrors
+// when files break a rule, *xvalidator.ConfigError for a malformed tag, or an error if a file cannot be read
+// for content sniffing.
+func Validate(s any) error {
+	value := reflect.ValueOf(s)
+	for value.Kind() == reflect.Pointer && !value.IsNil() {
+		value = value.Elem()
+	}
+	if value.Kind() != reflect.Struct {
+		return &xvalidator.ConfigError{Tag: "file", Reason: "Validate expects a struct or a pointer to a struct"}
+	}
+
+	var errs ValidationErrors
+	if err := validateStruct(value, &errs); err != nil {
+		return err
+	}
+	if len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
+
+// validateStruct appends the upload errors of the fields of the struct value to errs.
+func validateStruct(value reflect.Value, errs *ValidationErrors) error {
+	structType := value.Type()
+	for i := 0; i < structType.NumField(); i++ {
+		structField := structType.Field(i)
+		if !structField.IsExported() {
+			continue
+		}
+		field := value.Field(i)
+
+		tag, tagged := structField.Tag.Lookup("file")
+		switch {
+		case tagged && (field.Type() == fileHeaderType || field.Type() == fileHeaderSliceType):
+			rules, err := parseTag(tag)
+			if err != nil {
+				return err
+			}
+			if err := validateField(fieldName(structField), field, rules, errs); err != nil {
+				return err
+			}
+		case tagged:
+			return &xvalidator.ConfigError{Tag: "file", Param: tag, Reason: "file tags apply to *multipart.FileHeader fields"}
+		case field.Kind() == reflect.Struct:
+			if err := validateStruct(field, errs); err != nil {
+				return err
+			}
+		case field.Kind() == reflect.Pointer && !field.IsNil() && field.Elem().Kind() == reflect.Struct:
+			if err := validateStruct(field.Elem(), errs); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// fieldName returns the name of a field in messages: its form tag, its json tag or its Go name.
+func fieldName(field reflect.StructField) string {
+	for _, key := range []string{"form", "json"} {
+		if name, _, _ := strings.Cut(field.Tag.Get(key), ","); name != "" && name != "-" {
+			return name
+		}
+	}
+	return field.Name
+}
+
+// validateField checks the files of one field against its rules, appending at most one error per file.
+func validateField(name string, field reflect.Value, rules []rule, errs *ValidationErrors) error {
+	var files []*multipart.FileHeader
+	if field.Type() == fileHeaderType {
+		if !field.IsNil() {
+			files = append(files, field.Interface().(*multipart.FileHeader))
+		}
+	} else {
+		files = field.Interface().([]*multipart.FileHeader)
+	}
+
+	for _, r := range rules {
+		switch {
+		case r.name == "required" && len(files) == 0:
+			*errs = append(*errs, FieldError{Field: name, Rule: r.name, Message: name + " is required"})
+			return nil
+		case r.name == "maxfiles" && len(files) > r.count:
+			*errs = append(*errs, FieldError{Field: name, Rule: r.name, Param: r.param,
+				Message: fmt.Sprintf("%s must contain at most %d files", name, r.count)})
+			return nil
+		}
+	}
+
+	for _, file := range files {
+		if file == nil {
+			continue
+		}
+		fieldErr, err := validateFile(name, file, rules)
+		if err != nil {
+			return err
+		}
+		if fieldErr != nil {
+			*errs = append(*errs, *fieldErr)
+		}
+	}
+	return nil
+}
+
+// validateFile checks a single file against the rules, returning the first failed rule.
+func validateFile(name string, file *multipart.FileHeader, rules []rule) (*FieldError, error) {
+	for _, r := range rules {
+		var message string
+		switch r.name {
+		case "maxsize":
+			if file.Size > r.size {
+				message = fmt.Sprintf("%s must be at most %s", name, r.param)
+			}
+		case "minsize":
+			if file.Size < r.size {
+				message = fmt.Sprintf("%s must be at least %s", name, r.param)
+			}
+		case "ext":
+			if !contains(r.values, strings.ToLower(path.Ext(file.Filename))) {
+				message = fmt.Sprintf("%s must be a file ending in %s", name, strings.Join(r.values, " or "))
+			}
+		case "mime":
+			detected, err := detectMIME(file)
+			if err != nil {
+				return nil, err
+			}
+			if !mimeAllowed(detected, r.values) {
+				message = fmt.Sprintf("%s must be a file of type %s", name, strings.Join(r.values, " or "))
+			}
+		}
+		if message != "" {
+			return &FieldError{Field: name, Rule: r.name, Param: r.param, Message: message}, nil
+		}
+	}
+	return nil, nil
+}
+
+// detectMIME sniffs the MIME type of an uploaded file from its first bytes, ignoring the client's Content-Type.
+func detectMIME(file *multipart.FileHeader) (*mimetype.MIME, error) {
+	f, err := file.Open()
+	if err != nil {
+		return nil, fmt.Errorf("xvupload: open %q: %w", file.Filename, err)
+	}
+	defer f.Close()
+
+	detected, err := mimetype.DetectReader(f)
+	if err != nil {
+		return nil, fmt.Errorf("xvupload: read %q: %w", file.Filename, err)
+	}
+	return detected, nil
+}
+
+// mimeAllowed reports whether the detected type, or one of its aliases, matches an allowlist entry exactly
+// or through a "type/*" wildcard. Parent types do not match: allowing text/plain does not allow text/html.
+func mimeAllowed(detected *mimetype.MIME, allowlist []string) bool {
+	for _, allowed := range allowlist {
+		if prefix, wildcard := strings.CutSuffix(allowed, "/*"); wildcard {
+			if strings.HasPrefix(detected.String(), prefix+"/") {
+				return true
+			}
+		} else if detected.Is(allowed) {
+			return true
+		}
+	}
+	return false
+}
+
+// contains reports whether values holds value.
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,175 @@
+package xvupload
+
+import (
+	"bytes"
+	"mime/multipart"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hotfixfirst/go-xvalidator"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// pngHeader is the signature of a PNG file, enough for content sniffing.
+var pngHeader = []byte("\x89PNG\r\n\x1a\n\x00\x00\x00\rIHDR\x00\x00\x00\x01\x00\x00\x00\x01\x08\x06\x00\x00\x00")
+
+// uploadFile is a file part of a multipart form built by formFiles.
+type uploadFile struct {
+	field, name string
+	content     []byte
+}
+
+// formFiles encodes files as a multipart form and parses it back, as an HTTP handler would receive them.
+func formFiles(t *testing.T, files ...uploadFile) map[string][]*multipart.FileHeader {
+	t.Helper()
+
+	var body bytes.Buffer
+	writer := multipart.NewWriter(&body)
+	for _, file := range files {
+		part, err := writer.CreateFormFile(file.field, file.name)
+		require.NoError(t, err)
+		_, err = part.Write(file.content)
+		require.NoError(t, err)
+	}
+	require.NoError(t, writer.Close())
+
+	req := httptest.NewRequest("POST", "/upload", &body)
+	req.Header.Set("Content-Type", writer.FormDataContentType())
+	require.NoError(t, req.ParseMultipartForm(1<<20))
+	return req.MultipartForm.File
+}
+
+type avatarForm struct {
+	Avatar *multipart.FileHeader `form:"avatar" file:"required,maxsize=1KB,mime=image/png image/jpeg,ext=png jpg jpeg"`
+}
+
+func TestValidateAvatar(t *testing.T) {
+	tests := []struct {
+		name     string
+		file     *uploadFile
+		expected string
+	}{
+		{name: "valid png", file: &uploadFile{"avatar", "me.PNG", pngHeader}},
+		{name: "missing", expected: "avatar is required"},
+		{name: "too large", file: &uploadFile{"avatar", "me.png", append(pngHeader, make([]byte, 1024)...)},
+			expected: "avatar must be at most 1KB"},
+		{name: "content is not an image", file: &uploadFile{"avatar", "me.png", []byte("<html><body>hi</body></html>")},
+			expected: "avatar must be a file of type image/png or image/jpeg"},
+		{name: "wrong extension", file: &uploadFile{"avatar", "me.gif", pngHeader},
+			expected: "avatar must be a file ending in .png or .jpg or .jpeg"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var form avatarForm
+			if tt.file != nil {
+				form.Avatar = formFiles(t, *tt.file)["avatar"][0]
+			}
+
+			err := Validate(&form)
+			if tt.expected == "" {
+				assert.NoError(t, err)
+				return
+			}
+
+			var errs ValidationErrors
+			require.ErrorAs(t, err, &errs)
+			require.Len(t, errs, 1)
+			assert.Equal(t, "avatar", errs[0].Field)
+			assert.Equal(t, tt.expected, errs[0].Message)
+		})
+	}
+}
+
+func TestValidateSliceAndNested(t *testing.T) {
+	type attachments struct {
+		Files []*multipart.FileHeader `json:"files" file:"maxfiles=2,mime=image/* text/plain"`
+	}
+	type ticketForm struct {
+		Subject     string
+		Attachments attachments
+	}
+
+	files := formFiles(t,
+		uploadFile{"files", "a.png", pngHeader},
+		uploadFile{"files", "b.txt", []byte("plain notes")},
+		uploadFile{"files", "c.html", []byte("<!DOCTYPE html><html></html>")},
+	)
+
+	t.Run("each file is checked", func(t *testing.T) {
+		err := Validate(ticketForm{Attachments: attachments{Files: files["files"][1:]}})
+
+		var errs ValidationErrors
+		require.ErrorAs(t, err, &errs)
+		require.Len(t, errs, 1)
+		assert.Equal(t, "mime", errs[0].Rule)
+		assert.Equal(t, "files must be a file of type image/* or text/plain", err.Error())
+	})
+
+	t.Run("too many files", func(t *testing.T) {
+		err := Validate(ticketForm{Attachments: attachments{Files: files["files"]}})
+		assert.EqualError(t, err, "files must contain at most 2 files")
+	})
+
+	t.Run("valid", func(t *testing.T) {
+		assert.NoError(t, Validate(ticketForm{Attachments: attachments{Files: files["files"][:2]}}))
+	})
+
+	t.Run("empty slice is optional", func(t *testing.T) {
+		assert.NoError(t, Validate(ticketForm{}))
+	})
+}
+
+func TestValidateMinSize(t *testing.T) {
+	type form struct {
+		Document *multipart.FileHeader `file:"minsize=0.01KiB"`
+	}
+	files := formFiles(t, uploadFile{"doc", "tiny.txt", []byte("hi")})
+	assert.EqualError(t, Validate(form{Document: files["doc"][0]}), "Document must be at least 0.01KiB")
+}
+
+func TestValidateConfigErrors(t *testing.T) {
+	tests := []struct {
+		name  string
+		value any
+		param string
+	}{
+		{"unknown rule", &struct {
+			F *multipart.FileHeader `file:"maxsize=1MB,virus_free"`
+		}{}, "virus_free"},
+		{"invalid size", &struct {
+			F *multipart.FileHeader `file:"maxsize=big"`
+		}{}, "maxsize=big"},
+		{"invalid count", &struct {
+			F []*multipart.FileHeader `file:"maxfiles=0"`
+		}{}, "maxfiles=0"},
+		{"tag on other field type", &struct {
+			F string `file:"required"`
+		}{}, "required"},
+		{"not a struct", "avatar.png", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := Validate(tt.value)
+
+			var configErr *xvalidator.ConfigError
+			require.ErrorAs(t, err, &configErr)
+			assert.Equal(t, "file", configErr.Tag)
+			assert.Equal(t, tt.param, configErr.Param)
+		})
+	}
+}
+
+func TestParseSize(t *testing.T) {
+	for param, expected := range map[string]int64{"512": 512, "1KB": 1024, "5MB": 5 << 20, "1.5mb": 3 << 19, "2GiB": 2 << 30, "10 B": 10} {
+		size, ok := parseSize(param)
+		assert.True(t, ok, param)
+		assert.Equal(t, expected, size, param)
+	}
+	for _, param := range []string{"", "MB", "-1KB", "5TB"} {
+		_, ok := parseSize(param)
+		assert.False(t, ok, param)
+	}
+}